@@ -0,0 +1,224 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package batch pairs the multi-status responses of the batch methods of the clients, e.g. DeviceClient.Add, back
+// to their requests and reports the outcome of each item, so that the failed subset of a batch can be retried.
+package batch
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// ItemResult is the outcome of one request of a batch
+type ItemResult struct {
+	// Index is the position of the request in the batch
+	Index int
+	// RequestId is the requestId of the request
+	RequestId string
+	// Id is the id of the created entity, if any
+	Id string
+	// StatusCode is the status code of the item, or 0 if no response was received for the item
+	StatusCode int
+	// Err is the error of the item, nil if the item succeeded
+	Err errors.EdgeX
+}
+
+// Succeeded checks whether the item succeeded
+func (ir ItemResult) Succeeded() bool {
+	return ir.Err == nil
+}
+
+// Conflict checks whether the item failed because it conflicts with an existing entity, e.g. a duplicate name
+func (ir ItemResult) Conflict() bool {
+	return ir.Err != nil && ir.StatusCode == http.StatusConflict
+}
+
+// Retryable checks whether the item failed for a reason that might be transient, i.e. no response was received or the
+// service was unavailable
+func (ir ItemResult) Retryable() bool {
+	if ir.Err == nil {
+		return false
+	}
+	switch ir.StatusCode {
+	case 0, http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// Result is the outcome of all the requests of a batch, in the order of the requests
+type Result struct {
+	Items []ItemResult
+}
+
+// NewResult pairs the responses of a batch method returning []BaseWithIdResponse with the requests identified by
+// requestIds, see RequestIds. The err returned by the batch method, if any, fails all the items it doesn't report
+// individually.
+func NewResult(requestIds []string, responses []dtoCommon.BaseWithIdResponse, err errors.EdgeX) Result {
+	bases := make([]dtoCommon.BaseResponse, len(responses))
+	ids := make([]string, len(responses))
+	for i, res := range responses {
+		bases[i] = res.BaseResponse
+		ids[i] = res.Id
+	}
+	return newResult(requestIds, bases, ids, err)
+}
+
+// NewResultFromBaseResponses pairs the responses of a batch method returning []BaseResponse, e.g. the Update methods,
+// with the requests identified by requestIds, see RequestIds.
+func NewResultFromBaseResponses(requestIds []string, responses []dtoCommon.BaseResponse, err errors.EdgeX) Result {
+	return newResult(requestIds, responses, make([]string, len(responses)), err)
+}
+
+func newResult(requestIds []string, responses []dtoCommon.BaseResponse, ids []string, err errors.EdgeX) Result {
+	// When the whole request fails, the response error might still carry the response of each item
+	if err != nil && len(responses) == 0 {
+		if responseErr, ok := errors.AsResponseError(err); ok && len(responseErr.Items) > 0 {
+			responses = make([]dtoCommon.BaseResponse, len(responseErr.Items))
+			for i, item := range responseErr.Items {
+				responses[i] = dtoCommon.NewBaseResponse(item.RequestId, item.ServerMessage, item.StatusCode)
+			}
+			ids = make([]string, len(responses))
+		}
+	}
+
+	// The responses are paired by requestId, falling back to their position for the requests without unique requestId
+	byRequestId := make(map[string]int, len(responses))
+	for i, res := range responses {
+		if res.RequestId == "" {
+			continue
+		}
+		if _, duplicated := byRequestId[res.RequestId]; duplicated {
+			byRequestId[res.RequestId] = -1
+			continue
+		}
+		byRequestId[res.RequestId] = i
+	}
+
+	result := Result{Items: make([]ItemResult, len(requestIds))}
+	for i, requestId := range requestIds {
+		item := ItemResult{Index: i, RequestId: requestId}
+		position, ok := byRequestId[requestId]
+		if !ok || position < 0 {
+			position, ok = i, i < len(responses) && (responses[i].RequestId == "" || responses[i].RequestId == requestId)
+		}
+
+		switch {
+		case ok:
+			res := responses[position]
+			item.Id = ids[position]
+			item.StatusCode = res.StatusCode
+			item.Err = itemError(res)
+		case err != nil:
+			// The status code stays 0 if the request failed without response, e.g. the service can't be reached
+			if responseErr, isResponse := errors.AsResponseError(err); isResponse {
+				item.StatusCode = responseErr.StatusCode
+			}
+			item.Err = errors.NewCommonEdgeXWrapper(err)
+		default:
+			item.Err = errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("no response for the request %s", requestId), nil)
+		}
+		result.Items[i] = item
+	}
+	return result
+}
+
+// itemError maps the status code of an item response to an EdgeX error, nil if the item succeeded
+func itemError(res dtoCommon.BaseResponse) errors.EdgeX {
+	if res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+	return errors.NewResponseError(res.StatusCode, res.RequestId, res.Message,
+		fmt.Sprintf("request %s failed, status code: %d, err: %s", res.RequestId, res.StatusCode, res.Message), nil)
+}
+
+// Succeeded returns the items that succeeded
+func (r Result) Succeeded() []ItemResult {
+	return r.filter(func(item ItemResult) bool { return item.Succeeded() })
+}
+
+// Failed returns the items that failed, except the conflicts
+func (r Result) Failed() []ItemResult {
+	return r.filter(func(item ItemResult) bool { return !item.Succeeded() && !item.Conflict() })
+}
+
+// Conflicts returns the items that failed because they conflict with an existing entity
+func (r Result) Conflicts() []ItemResult {
+	return r.filter(func(item ItemResult) bool { return item.Conflict() })
+}
+
+// RetryableIndexes returns the positions in the batch of the items whose failure might be transient
+func (r Result) RetryableIndexes() []int {
+	var indexes []int
+	for _, item := range r.Items {
+		if item.Retryable() {
+			indexes = append(indexes, item.Index)
+		}
+	}
+	return indexes
+}
+
+// Err returns an error summarizing the failed items, including the conflicts, or nil if all the items succeeded. The
+// kind of the error is the kind of the first failed item.
+func (r Result) Err() errors.EdgeX {
+	var first errors.EdgeX
+	failures := 0
+	for _, item := range r.Items {
+		if item.Err == nil {
+			continue
+		}
+		if first == nil {
+			first = item.Err
+		}
+		failures++
+	}
+	if first == nil {
+		return nil
+	}
+	return errors.NewCommonEdgeX(errors.Kind(first), fmt.Sprintf("%d of %d requests failed", failures, len(r.Items)), first)
+}
+
+func (r Result) filter(match func(ItemResult) bool) []ItemResult {
+	var items []ItemResult
+	for _, item := range r.Items {
+		if match(item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// RequestIds returns the requestId of each request of a batch, reqs must be a slice of request DTOs embedding
+// common.BaseRequest, e.g. []requests.AddDeviceRequest. An empty string is returned for the items without requestId.
+func RequestIds(reqs interface{}) []string {
+	value := reflect.ValueOf(reqs)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil
+	}
+	ids := make([]string, value.Len())
+	for i := range ids {
+		item := value.Index(i)
+		for item.Kind() == reflect.Ptr || item.Kind() == reflect.Interface {
+			if item.IsNil() {
+				break
+			}
+			item = item.Elem()
+		}
+		if item.Kind() != reflect.Struct {
+			continue
+		}
+		if field := item.FieldByName("RequestId"); field.IsValid() && field.Kind() == reflect.String {
+			ids[i] = field.String()
+		}
+	}
+	return ids
+}
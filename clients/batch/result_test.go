@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package batch
+
+import (
+	"net/http"
+	"testing"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestIds(t *testing.T) {
+	reqs := []requests.AddDeviceRequest{
+		{BaseRequest: dtoCommon.BaseRequest{RequestId: "1"}},
+		{},
+		{BaseRequest: dtoCommon.BaseRequest{RequestId: "3"}},
+	}
+	assert.Equal(t, []string{"1", "", "3"}, RequestIds(reqs))
+	assert.Equal(t, []string{"1"}, RequestIds([]*requests.AddDeviceRequest{&reqs[0]}))
+	assert.Nil(t, RequestIds("not a slice"))
+}
+
+func TestNewResult(t *testing.T) {
+	requestIds := []string{"1", "2", "3", "4"}
+	// The responses are out of order and the response of the last request is missing
+	responses := []dtoCommon.BaseWithIdResponse{
+		dtoCommon.NewBaseWithIdResponse("3", "service unavailable", http.StatusServiceUnavailable, ""),
+		dtoCommon.NewBaseWithIdResponse("1", "", http.StatusCreated, "id-1"),
+		dtoCommon.NewBaseWithIdResponse("2", "device name exists", http.StatusConflict, ""),
+	}
+
+	result := NewResult(requestIds, responses, nil)
+	require.Len(t, result.Items, 4)
+	assert.True(t, result.Items[0].Succeeded())
+	assert.Equal(t, "id-1", result.Items[0].Id)
+	assert.True(t, result.Items[1].Conflict())
+	assert.Equal(t, errors.KindStatusConflict, errors.Kind(result.Items[1].Err))
+	assert.True(t, result.Items[2].Retryable())
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(result.Items[2].Err))
+	assert.Equal(t, errors.KindServerError, errors.Kind(result.Items[3].Err))
+
+	assert.Len(t, result.Succeeded(), 1)
+	assert.Len(t, result.Conflicts(), 1)
+	assert.Len(t, result.Failed(), 2)
+	// the request without response might not have reached the service
+	assert.Equal(t, []int{2, 3}, result.RetryableIndexes())
+
+	err := result.Err()
+	require.Error(t, err)
+	assert.Equal(t, errors.KindStatusConflict, errors.Kind(err))
+}
+
+func TestNewResultPairsByPosition(t *testing.T) {
+	responses := []dtoCommon.BaseResponse{
+		dtoCommon.NewBaseResponse("", "", http.StatusOK),
+		dtoCommon.NewBaseResponse("", "invalid", http.StatusBadRequest),
+	}
+	result := NewResultFromBaseResponses([]string{"", ""}, responses, nil)
+	assert.True(t, result.Items[0].Succeeded())
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(result.Items[1].Err))
+	assert.False(t, result.Items[1].Retryable())
+}
+
+func TestNewResultWithError(t *testing.T) {
+	requestIds := []string{"1", "2"}
+
+	unreachable := errors.NewCommonEdgeX(errors.KindServerError, "failed to send a http request", nil)
+	result := NewResult(requestIds, nil, unreachable)
+	assert.Equal(t, []int{0, 1}, result.RetryableIndexes())
+
+	items := []errors.ResponseError{
+		errors.NewResponseError(http.StatusBadRequest, "2", "invalid", "invalid", nil),
+		errors.NewResponseError(http.StatusInternalServerError, "1", "database failed", "database failed", nil),
+	}
+	failed := errors.NewCommonEdgeXWrapper(errors.NewResponseError(http.StatusBadRequest, "", "", "request failed", items))
+	result = NewResult(requestIds, nil, failed)
+	assert.Equal(t, http.StatusInternalServerError, result.Items[0].StatusCode)
+	assert.Equal(t, http.StatusBadRequest, result.Items[1].StatusCode)
+	assert.Equal(t, []int{0}, result.RetryableIndexes())
+}
@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package batch
+
+import (
+	"context"
+	"time"
+)
+
+// SendFunc sends the requests of the batch at the specified positions and returns the result of this subset, e.g.
+//
+//	func(ctx context.Context, indexes []int) batch.Result {
+//		subset := make([]requests.AddDeviceRequest, len(indexes))
+//		for i, index := range indexes {
+//			subset[i] = reqs[index]
+//		}
+//		res, err := client.Add(ctx, subset)
+//		return batch.NewResult(batch.RequestIds(subset), res, err)
+//	}
+type SendFunc func(ctx context.Context, indexes []int) Result
+
+// RetryFailed resends the items of the result whose failure might be transient until they succeed, fail for good or
+// maxAttempts attempts, including the one of the initial result, have been made. The interval is waited between two
+// attempts. The returned result merges the outcome of the last attempt of each item in the order of the batch.
+func RetryFailed(ctx context.Context, result Result, maxAttempts int, interval time.Duration, send SendFunc) Result {
+	merged := Result{Items: append([]ItemResult(nil), result.Items...)}
+	for attempt := 1; attempt < maxAttempts; attempt++ {
+		indexes := merged.RetryableIndexes()
+		if len(indexes) == 0 {
+			break
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return merged
+		case <-timer.C:
+		}
+
+		retried := send(ctx, indexes)
+		for i, index := range indexes {
+			if i >= len(retried.Items) {
+				break
+			}
+			item := retried.Items[i]
+			item.Index = index
+			merged.Items[index] = item
+		}
+	}
+	return merged
+}
@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package batch
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryFailed(t *testing.T) {
+	requestIds := []string{"1", "2", "3"}
+	initial := NewResult(requestIds, []dtoCommon.BaseWithIdResponse{
+		dtoCommon.NewBaseWithIdResponse("1", "", http.StatusCreated, "id-1"),
+		dtoCommon.NewBaseWithIdResponse("2", "unavailable", http.StatusServiceUnavailable, ""),
+		dtoCommon.NewBaseWithIdResponse("3", "unavailable", http.StatusServiceUnavailable, ""),
+	}, nil)
+
+	var sent [][]int
+	result := RetryFailed(context.Background(), initial, 3, time.Millisecond, func(ctx context.Context, indexes []int) Result {
+		sent = append(sent, indexes)
+		subset := make([]string, len(indexes))
+		responses := make([]dtoCommon.BaseWithIdResponse, len(indexes))
+		for i, index := range indexes {
+			subset[i] = requestIds[index]
+			statusCode := http.StatusServiceUnavailable
+			// the request 2 succeeds on the first retry while the request 3 keeps failing
+			if requestIds[index] == "2" {
+				statusCode = http.StatusCreated
+			}
+			responses[i] = dtoCommon.NewBaseWithIdResponse(requestIds[index], "", statusCode, "id-"+requestIds[index])
+		}
+		return NewResult(subset, responses, nil)
+	})
+
+	assert.Equal(t, [][]int{{1, 2}, {2}}, sent)
+	require.Len(t, result.Items, 3)
+	assert.True(t, result.Items[0].Succeeded())
+	assert.True(t, result.Items[1].Succeeded())
+	assert.Equal(t, "id-2", result.Items[1].Id)
+	assert.Equal(t, 1, result.Items[1].Index)
+	assert.True(t, result.Items[2].Retryable())
+	assert.Equal(t, 2, result.Items[2].Index)
+}
+
+func TestRetryFailedStopsWhenContextDone(t *testing.T) {
+	initial := NewResult([]string{"1"}, nil, nil)
+	initial.Items[0].StatusCode = http.StatusServiceUnavailable
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := RetryFailed(ctx, initial, 3, time.Minute, func(ctx context.Context, indexes []int) Result {
+		t.Fatal("no request is expected once the context is done")
+		return Result{}
+	})
+	assert.Equal(t, initial, result)
+}
@@ -0,0 +1,205 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bulk splits the requests of the array-taking Add and Update methods of the clients into chunks, so that a
+// large batch doesn't exceed the request size limit of the service. The chunks are optionally sent concurrently, and
+// their responses are merged in the order of the requests, one response per request.
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const (
+	defaultChunkSize   = 100
+	defaultConcurrency = 1
+)
+
+// Settings configures how the requests are split and sent
+type Settings struct {
+	// ChunkSize is the maximum number of requests sent in one call. Default is 100.
+	ChunkSize int
+	// Concurrency is the maximum number of chunks sent at the same time. Default is 1, i.e. the chunks are sent one
+	// after the other.
+	Concurrency int
+}
+
+func (s Settings) withDefaults() Settings {
+	if s.ChunkSize <= 0 {
+		s.ChunkSize = defaultChunkSize
+	}
+	if s.Concurrency <= 0 {
+		s.Concurrency = defaultConcurrency
+	}
+	return s
+}
+
+// chunkFunc sends the requests of the batch from start to end, excluded
+type chunkFunc func(ctx context.Context, start int, end int) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX)
+
+// send sends the chunks of the batch and merges their responses in the order of the requests. A chunk failing as a
+// whole is reported with one failed response per request, so that the responses can still be paired with the
+// requests, and the returned error summarizes the failed chunks. A chunk missing the responses of some requests also
+// counts as failed.
+func (s Settings) send(ctx context.Context, requestIds []string, send chunkFunc) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	s = s.withDefaults()
+	total := len(requestIds)
+	chunks := (total + s.ChunkSize - 1) / s.ChunkSize
+	responses := make([][]dtoCommon.BaseWithIdResponse, chunks)
+	errs := make([]errors.EdgeX, chunks)
+
+	semaphore := make(chan struct{}, s.Concurrency)
+	var wg sync.WaitGroup
+	for chunk := 0; chunk < chunks; chunk++ {
+		start := chunk * s.ChunkSize
+		end := start + s.ChunkSize
+		if end > total {
+			end = total
+		}
+		if err := acquire(ctx, semaphore); err != nil {
+			errs[chunk] = err
+			continue
+		}
+		wg.Add(1)
+		go func(chunk int, start int, end int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			responses[chunk], errs[chunk] = send(ctx, start, end)
+		}(chunk, start, end)
+	}
+	wg.Wait()
+
+	merged := make([]dtoCommon.BaseWithIdResponse, 0, total)
+	var first errors.EdgeX
+	failures := 0
+	for chunk := 0; chunk < chunks; chunk++ {
+		start := chunk * s.ChunkSize
+		end := start + s.ChunkSize
+		if end > total {
+			end = total
+		}
+		chunkIds := requestIds[start:end]
+		err := errs[chunk]
+		chunkResponses := responses[chunk]
+		if err != nil {
+			chunkResponses = failedResponses(chunkIds, chunkResponses, err)
+		}
+		paired, missing := pair(chunkIds, chunkResponses)
+		if len(missing) > 0 && err == nil {
+			err = errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("no response for %d of the %d requests of the chunk", len(missing), len(chunkIds)), nil)
+		}
+		for _, i := range missing {
+			paired[i] = failedResponse(chunkIds[i], err)
+		}
+		merged = append(merged, paired...)
+		if err == nil {
+			continue
+		}
+		if first == nil {
+			first = err
+		}
+		failures++
+	}
+	if first != nil {
+		return merged, errors.NewCommonEdgeX(errors.Kind(first), fmt.Sprintf("%d of %d chunks failed", failures, chunks), first)
+	}
+	return merged, nil
+}
+
+// acquire waits for a free slot of the semaphore, it fails once the context is done
+func acquire(ctx context.Context, semaphore chan struct{}) errors.EdgeX {
+	if err := ctx.Err(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "the bulk request is canceled", err)
+	}
+	select {
+	case semaphore <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return errors.NewCommonEdgeX(errors.KindServerError, "the bulk request is canceled", ctx.Err())
+	}
+}
+
+// failedResponses returns the responses of a failed chunk, either the ones carried by the error or one failed response
+// per request. The status code of the failed responses is 0 if the chunk failed without response.
+func failedResponses(requestIds []string, responses []dtoCommon.BaseWithIdResponse, err errors.EdgeX) []dtoCommon.BaseWithIdResponse {
+	if len(responses) > 0 {
+		return responses
+	}
+	responseErr, isResponse := errors.AsResponseError(err)
+	if isResponse && len(responseErr.Items) > 0 {
+		failed := make([]dtoCommon.BaseWithIdResponse, len(responseErr.Items))
+		for i, item := range responseErr.Items {
+			failed[i] = dtoCommon.NewBaseWithIdResponse(item.RequestId, item.ServerMessage, item.StatusCode, "")
+		}
+		return failed
+	}
+	failed := make([]dtoCommon.BaseWithIdResponse, len(requestIds))
+	for i, requestId := range requestIds {
+		failed[i] = failedResponse(requestId, err)
+	}
+	return failed
+}
+
+// failedResponse returns the failed response of the request, whose status code is 0 if it failed without response
+func failedResponse(requestId string, err errors.EdgeX) dtoCommon.BaseWithIdResponse {
+	statusCode := 0
+	if responseErr, isResponse := errors.AsResponseError(err); isResponse {
+		statusCode = responseErr.StatusCode
+	}
+	return dtoCommon.NewBaseWithIdResponse(requestId, err.Error(), statusCode, "")
+}
+
+// pair returns the responses of a chunk in the order of its requests. The responses are paired by requestId, falling
+// back to their position for the requests without unique requestId as batch.NewResult does, and the positions of the
+// requests without response are returned as missing.
+func pair(requestIds []string, responses []dtoCommon.BaseWithIdResponse) (paired []dtoCommon.BaseWithIdResponse, missing []int) {
+	byRequestId := make(map[string]int, len(responses))
+	for i, res := range responses {
+		if res.RequestId == "" {
+			continue
+		}
+		if _, duplicated := byRequestId[res.RequestId]; duplicated {
+			byRequestId[res.RequestId] = -1
+			continue
+		}
+		byRequestId[res.RequestId] = i
+	}
+
+	paired = make([]dtoCommon.BaseWithIdResponse, len(requestIds))
+	for i, requestId := range requestIds {
+		position, ok := byRequestId[requestId]
+		if !ok || position < 0 {
+			position, ok = i, i < len(responses) && (responses[i].RequestId == "" || responses[i].RequestId == requestId)
+		}
+		if !ok {
+			missing = append(missing, i)
+			continue
+		}
+		paired[i] = responses[position]
+	}
+	return paired, missing
+}
+
+// sendUpdates sends the chunks of a batch method returning []BaseResponse
+func (s Settings) sendUpdates(ctx context.Context, requestIds []string, send func(ctx context.Context, start int, end int) ([]dtoCommon.BaseResponse, errors.EdgeX)) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	merged, err := s.send(ctx, requestIds, func(ctx context.Context, start int, end int) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+		res, err := send(ctx, start, end)
+		withIds := make([]dtoCommon.BaseWithIdResponse, len(res))
+		for i, r := range res {
+			withIds[i] = dtoCommon.BaseWithIdResponse{BaseResponse: r}
+		}
+		return withIds, err
+	})
+	res := make([]dtoCommon.BaseResponse, len(merged))
+	for i, r := range merged {
+		res[i] = r.BaseResponse
+	}
+	return res, err
+}
@@ -0,0 +1,135 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRequestIds(count int) []string {
+	ids := make([]string, count)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("%d", i)
+	}
+	return ids
+}
+
+func TestSendKeepsTheOrderOfTheRequests(t *testing.T) {
+	requestIds := testRequestIds(10)
+	var running, maxRunning int32
+	send := func(ctx context.Context, start int, end int) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			observed := atomic.LoadInt32(&maxRunning)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+				break
+			}
+		}
+		// the first chunks complete last
+		time.Sleep(time.Duration(10-start) * time.Millisecond)
+		res := make([]dtoCommon.BaseWithIdResponse, 0, end-start)
+		for _, requestId := range requestIds[start:end] {
+			res = append(res, dtoCommon.NewBaseWithIdResponse(requestId, "", http.StatusCreated, "id-"+requestId))
+		}
+		return res, nil
+	}
+
+	res, err := Settings{ChunkSize: 3, Concurrency: 2}.send(context.Background(), requestIds, send)
+	require.NoError(t, err)
+	require.Len(t, res, 10)
+	for i, r := range res {
+		assert.Equal(t, requestIds[i], r.RequestId)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&maxRunning))
+}
+
+func TestSendReportsFailedChunks(t *testing.T) {
+	requestIds := testRequestIds(5)
+	send := func(ctx context.Context, start int, end int) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+		if start == 2 {
+			return nil, errors.NewResponseError(http.StatusRequestEntityTooLarge, "", "too large", "request failed", nil)
+		}
+		res := make([]dtoCommon.BaseWithIdResponse, 0, end-start)
+		for _, requestId := range requestIds[start:end] {
+			res = append(res, dtoCommon.NewBaseWithIdResponse(requestId, "", http.StatusCreated, ""))
+		}
+		return res, nil
+	}
+
+	res, err := Settings{ChunkSize: 2}.send(context.Background(), requestIds, send)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+	require.Len(t, res, 5)
+	for i, r := range res {
+		assert.Equal(t, requestIds[i], r.RequestId)
+		if i == 2 || i == 3 {
+			assert.Equal(t, http.StatusRequestEntityTooLarge, r.StatusCode)
+		} else {
+			assert.Equal(t, http.StatusCreated, r.StatusCode)
+		}
+	}
+}
+
+func TestSendStopsWhenContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int32
+	send := func(ctx context.Context, start int, end int) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+		atomic.AddInt32(&calls, 1)
+		cancel()
+		return []dtoCommon.BaseWithIdResponse{dtoCommon.NewBaseWithIdResponse("0", "", http.StatusCreated, "")}, nil
+	}
+
+	res, err := Settings{ChunkSize: 1}.send(ctx, testRequestIds(3), send)
+	require.Error(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	require.Len(t, res, 3)
+	assert.Equal(t, http.StatusCreated, res[0].StatusCode)
+	assert.Equal(t, 0, res[1].StatusCode)
+	assert.Equal(t, 0, res[2].StatusCode)
+}
+
+func TestSendPairsTheResponsesOfAChunk(t *testing.T) {
+	requestIds := testRequestIds(6)
+	send := func(ctx context.Context, start int, end int) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+		// the responses are reversed, and the last request of the first chunk has no response
+		var res []dtoCommon.BaseWithIdResponse
+		for i := end - 1; i >= start; i-- {
+			if i == 2 {
+				continue
+			}
+			res = append(res, dtoCommon.NewBaseWithIdResponse(requestIds[i], "", http.StatusCreated, "id-"+requestIds[i]))
+		}
+		return res, nil
+	}
+
+	res, err := Settings{ChunkSize: 3}.send(context.Background(), requestIds, send)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServerError, errors.Kind(err))
+	assert.Contains(t, err.Error(), "1 of 2 chunks failed")
+	require.Len(t, res, 6)
+	for i, r := range res {
+		assert.Equal(t, requestIds[i], r.RequestId)
+		if i == 2 {
+			assert.Equal(t, 0, r.StatusCode, "the request without response should be failed")
+			assert.Empty(t, r.Id)
+		} else {
+			assert.Equal(t, http.StatusCreated, r.StatusCode)
+			assert.Equal(t, "id-"+requestIds[i], r.Id)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bulk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddDevices(t *testing.T) {
+	reqs := []requests.AddDeviceRequest{
+		requests.NewAddDeviceRequest(dtos.Device{Name: "a"}),
+		requests.NewAddDeviceRequest(dtos.Device{Name: "b"}),
+		requests.NewAddDeviceRequest(dtos.Device{Name: "c"}),
+	}
+	client := &mocks.DeviceClient{}
+	client.On("Add", mock.Anything, reqs[0:2]).Return([]dtoCommon.BaseWithIdResponse{
+		dtoCommon.NewBaseWithIdResponse(reqs[0].RequestId, "", http.StatusCreated, "id-a"),
+		dtoCommon.NewBaseWithIdResponse(reqs[1].RequestId, "", http.StatusCreated, "id-b"),
+	}, nil).Once()
+	client.On("Add", mock.Anything, reqs[2:3]).Return([]dtoCommon.BaseWithIdResponse{
+		dtoCommon.NewBaseWithIdResponse(reqs[2].RequestId, "", http.StatusCreated, "id-c"),
+	}, nil).Once()
+
+	res, err := AddDevices(context.Background(), client, reqs, Settings{ChunkSize: 2})
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	assert.Equal(t, "id-a", res[0].Id)
+	assert.Equal(t, "id-b", res[1].Id)
+	assert.Equal(t, "id-c", res[2].Id)
+	client.AssertExpectations(t)
+}
+
+func TestUpdateDevices(t *testing.T) {
+	name := "a"
+	reqs := []requests.UpdateDeviceRequest{requests.NewUpdateDeviceRequest(dtos.UpdateDevice{Name: &name})}
+	client := &mocks.DeviceClient{}
+	client.On("Update", mock.Anything, reqs).Return([]dtoCommon.BaseResponse{
+		dtoCommon.NewBaseResponse(reqs[0].RequestId, "", http.StatusOK),
+	}, nil).Once()
+
+	res, err := UpdateDevices(context.Background(), client, reqs, Settings{})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, reqs[0].RequestId, res[0].RequestId)
+	client.AssertExpectations(t)
+}
@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bulk
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/batch"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// AddSubscriptions adds the subscriptions in chunks with SubscriptionClient.Add. The responses are returned in the
+// order of the requests even if some chunks fail, in which case the error summarizes the failed chunks.
+func AddSubscriptions(ctx context.Context, client interfaces.SubscriptionClient, reqs []requests.AddSubscriptionRequest, settings Settings) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	return settings.send(ctx, batch.RequestIds(reqs), func(ctx context.Context, start int, end int) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+		return client.Add(ctx, reqs[start:end])
+	})
+}
+
+// UpdateSubscriptions updates the subscriptions in chunks with SubscriptionClient.Update. The responses are returned in
+// the order of the requests even if some chunks fail, in which case the error summarizes the failed chunks.
+func UpdateSubscriptions(ctx context.Context, client interfaces.SubscriptionClient, reqs []requests.UpdateSubscriptionRequest, settings Settings) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	return settings.sendUpdates(ctx, batch.RequestIds(reqs), func(ctx context.Context, start int, end int) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+		return client.Update(ctx, reqs[start:end])
+	})
+}
@@ -0,0 +1,176 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cache wraps the core-metadata clients with a client side cache of the entities queried by name, e.g.
+// DeviceClient.DeviceByName, so that the services looking up the same metadata on every command don't query
+// core-metadata each time. A cached entity is served as is during its TTL. Once the TTL has elapsed, the entity is
+// revalidated with a conditional request carrying the If-None-Match and If-Modified-Since headers derived from its
+// DBTimestamp.Modified, a 304 Not Modified response keeping the cached entity. The entities updated or deleted through
+// the cached client are invalidated right away.
+package cache
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const defaultTTL = 30 * time.Second
+
+// Settings configures a cached client
+type Settings struct {
+	// TTL is how long a cached entity is served without revalidation. Default is 30s.
+	TTL time.Duration
+}
+
+// Metrics counts the lookups of a cached client
+type Metrics struct {
+	// Hits is the number of lookups served from the cache, including the revalidated ones
+	Hits uint64
+	// Misses is the number of lookups for which the entity was fetched from the service
+	Misses uint64
+	// Revalidations is the number of hits for which the service confirmed that the entity is not modified
+	Revalidations uint64
+}
+
+// fetchFunc queries the entity from the service and returns it along with its DBTimestamp.Modified
+type fetchFunc func(ctx context.Context) (interface{}, int64, errors.EdgeX)
+
+type entry struct {
+	value     interface{}
+	modified  int64
+	validated time.Time
+}
+
+// store holds the entities of a cached client keyed by name, and counts the lookups
+type store struct {
+	ttl        time.Duration
+	entries    map[string]entry
+	generation uint64
+	mutex      sync.Mutex
+
+	hits          uint64
+	misses        uint64
+	revalidations uint64
+}
+
+func newStore(settings Settings) *store {
+	if settings.TTL <= 0 {
+		settings.TTL = defaultTTL
+	}
+	return &store{
+		ttl:     settings.TTL,
+		entries: make(map[string]entry),
+	}
+}
+
+// get returns the cached entity of the name, fetching it when it isn't cached or revalidating it when its TTL has
+// elapsed
+func (s *store) get(ctx context.Context, name string, fetch fetchFunc) (interface{}, errors.EdgeX) {
+	s.mutex.Lock()
+	cached, ok := s.entries[name]
+	generation := s.generation
+	s.mutex.Unlock()
+
+	if ok && time.Since(cached.validated) < s.ttl {
+		atomic.AddUint64(&s.hits, 1)
+		return cached.value, nil
+	}
+
+	fetchCtx := ctx
+	if ok && cached.modified > 0 {
+		fetchCtx = conditionalContext(ctx, cached.modified)
+	}
+	value, modified, err := fetch(fetchCtx)
+	if err != nil {
+		if ok && cached.modified > 0 && notModified(err) {
+			atomic.AddUint64(&s.hits, 1)
+			atomic.AddUint64(&s.revalidations, 1)
+			s.put(name, cached.value, cached.modified, generation)
+			return cached.value, nil
+		}
+		atomic.AddUint64(&s.misses, 1)
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	atomic.AddUint64(&s.misses, 1)
+	s.put(name, value, modified, generation)
+	return value, nil
+}
+
+// put caches the entity unless the cache was invalidated since the entity was looked up, in which case the entity
+// might be stale already
+func (s *store) put(name string, value interface{}, modified int64, generation uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.generation != generation {
+		return
+	}
+	s.entries[name] = entry{value: value, modified: modified, validated: time.Now()}
+}
+
+// Invalidate removes the entity of the name from the cache
+func (s *store) Invalidate(name string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.entries, name)
+	s.generation++
+}
+
+// InvalidateAll empties the cache
+func (s *store) InvalidateAll() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.entries = make(map[string]entry)
+	s.generation++
+}
+
+// Metrics returns the lookup counts of the cached client
+func (s *store) Metrics() Metrics {
+	return Metrics{
+		Hits:          atomic.LoadUint64(&s.hits),
+		Misses:        atomic.LoadUint64(&s.misses),
+		Revalidations: atomic.LoadUint64(&s.revalidations),
+	}
+}
+
+// invalidateUpdated invalidates the named entities of an update, or the whole cache if an entity of the update is
+// identified by its id, since it might be renamed and its former name is unknown
+func (s *store) invalidateUpdated(ids []*string, names []*string) {
+	for i, name := range names {
+		if name == nil || *name == "" || (ids[i] != nil && *ids[i] != "") {
+			s.InvalidateAll()
+			return
+		}
+	}
+	for _, name := range names {
+		s.Invalidate(*name)
+	}
+}
+
+// conditionalContext returns a copy of ctx making the request conditional on the entity being modified after the
+// modified timestamp, in milliseconds
+func conditionalContext(ctx context.Context, modified int64) context.Context {
+	return utils.WithConditionalGet(ctx, utils.ConditionalGet{
+		ETag:          ETag(modified),
+		ModifiedSince: time.Unix(0, modified*int64(time.Millisecond)),
+	})
+}
+
+// ETag returns the entity tag of an entity whose DBTimestamp.Modified is modified, which is the value of the
+// If-None-Match header of the revalidation requests
+func ETag(modified int64) string {
+	return strconv.Quote(strconv.FormatInt(modified, 10))
+}
+
+func notModified(err errors.EdgeX) bool {
+	responseErr, ok := errors.AsResponseError(err)
+	return ok && responseErr.StatusCode == http.StatusNotModified
+}
@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/requestcontext"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeService serves an entity through a fetchFunc, answering the conditional requests as the service would
+type fakeService struct {
+	value      string
+	modified   int64
+	fetches    int
+	conditions utils.ConditionalGet
+	forwarded  http.Header
+}
+
+func (fs *fakeService) fetch(ctx context.Context) (interface{}, int64, errors.EdgeX) {
+	fs.fetches++
+	fs.conditions, _ = utils.ConditionalGetFromContext(ctx)
+	fs.forwarded = requestcontext.ForwardedHeaders(ctx)
+	if fs.conditions.ETag == ETag(fs.modified) {
+		return nil, 0, errors.NewResponseError(http.StatusNotModified, "", "", "not modified", nil)
+	}
+	return fs.value, fs.modified, nil
+}
+
+func TestStoreGet(t *testing.T) {
+	s := newStore(Settings{TTL: 20 * time.Millisecond})
+	service := &fakeService{value: "v1", modified: 1609459200000}
+
+	value, err := s.get(context.Background(), "test", service.fetch)
+	require.NoError(t, err)
+	assert.Equal(t, "v1", value)
+	assert.Empty(t, service.conditions.ETag)
+
+	// served from the cache during the TTL
+	value, err = s.get(context.Background(), "test", service.fetch)
+	require.NoError(t, err)
+	assert.Equal(t, "v1", value)
+	assert.Equal(t, 1, service.fetches)
+
+	// revalidated once the TTL has elapsed
+	time.Sleep(25 * time.Millisecond)
+	value, err = s.get(context.Background(), "test", service.fetch)
+	require.NoError(t, err)
+	assert.Equal(t, "v1", value)
+	assert.Equal(t, 2, service.fetches)
+	assert.Equal(t, `"1609459200000"`, service.conditions.ETag)
+	assert.Equal(t, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), service.conditions.ModifiedSince.UTC())
+	assert.Empty(t, service.forwarded, "the validators aren't forwarded headers")
+
+	// fetched again once modified
+	time.Sleep(25 * time.Millisecond)
+	service.value, service.modified = "v2", 1609459201000
+	value, err = s.get(context.Background(), "test", service.fetch)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", value)
+
+	assert.Equal(t, Metrics{Hits: 2, Misses: 2, Revalidations: 1}, s.Metrics())
+}
+
+func TestStoreInvalidate(t *testing.T) {
+	s := newStore(Settings{})
+	service := &fakeService{value: "v1", modified: 1}
+	_, err := s.get(context.Background(), "test", service.fetch)
+	require.NoError(t, err)
+
+	s.Invalidate("test")
+	_, err = s.get(context.Background(), "test", service.fetch)
+	require.NoError(t, err)
+	assert.Equal(t, 2, service.fetches)
+	assert.Empty(t, service.conditions.ETag)
+
+	// an entity looked up before an invalidation might be stale, it isn't cached
+	_, err = s.get(context.Background(), "other", func(ctx context.Context) (interface{}, int64, errors.EdgeX) {
+		s.InvalidateAll()
+		return "stale", 1, nil
+	})
+	require.NoError(t, err)
+	s.mutex.Lock()
+	assert.Empty(t, s.entries)
+	s.mutex.Unlock()
+
+	name := "test"
+	s.invalidateUpdated([]*string{nil, nil}, []*string{&name, nil})
+	assert.Empty(t, s.entries)
+}
+
+func TestStoreFetchError(t *testing.T) {
+	s := newStore(Settings{})
+	_, err := s.get(context.Background(), "test", func(ctx context.Context) (interface{}, int64, errors.EdgeX) {
+		return nil, 0, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+	})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	assert.Equal(t, Metrics{Misses: 1}, s.Metrics())
+}
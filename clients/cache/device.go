@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// DeviceClient caches the devices queried by name, the other methods are passed through to the wrapped client
+type DeviceClient struct {
+	interfaces.DeviceClient
+	*store
+}
+
+// NewDeviceClient wraps the DeviceClient with a cache of the devices queried by name
+func NewDeviceClient(client interfaces.DeviceClient, settings Settings) *DeviceClient {
+	return &DeviceClient{
+		DeviceClient: client,
+		store:        newStore(settings),
+	}
+}
+
+// DeviceByName returns the cached device, querying or revalidating it if needed
+func (c *DeviceClient) DeviceByName(ctx context.Context, name string) (responses.DeviceResponse, errors.EdgeX) {
+	value, err := c.get(ctx, name, func(ctx context.Context) (interface{}, int64, errors.EdgeX) {
+		res, err := c.DeviceClient.DeviceByName(ctx, name)
+		return res, res.Device.Modified, err
+	})
+	if err != nil {
+		return responses.DeviceResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return value.(responses.DeviceResponse), nil
+}
+
+// Update updates the devices and invalidates them
+func (c *DeviceClient) Update(ctx context.Context, reqs []requests.UpdateDeviceRequest) ([]common.BaseResponse, errors.EdgeX) {
+	res, err := c.DeviceClient.Update(ctx, reqs)
+	ids := make([]*string, len(reqs))
+	names := make([]*string, len(reqs))
+	for i, req := range reqs {
+		ids[i], names[i] = req.Device.Id, req.Device.Name
+	}
+	c.invalidateUpdated(ids, names)
+	return res, err
+}
+
+// DeleteDeviceByName deletes the device and invalidates it
+func (c *DeviceClient) DeleteDeviceByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	res, err := c.DeviceClient.DeleteDeviceByName(ctx, name)
+	c.Invalidate(name)
+	return res, err
+}
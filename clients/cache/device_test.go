@@ -0,0 +1,79 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceClientInvalidation(t *testing.T) {
+	name := "testDevice"
+	device := dtos.Device{Name: name, DBTimestamp: dtos.DBTimestamp{Modified: 1}}
+	client := &mocks.DeviceClient{}
+	client.On("DeviceByName", mock.Anything, name).Return(responses.DeviceResponse{Device: device}, nil)
+	client.On("Update", mock.Anything, mock.Anything).Return(nil, nil)
+	client.On("DeleteDeviceByName", mock.Anything, name).Return(dtoCommon.BaseResponse{}, nil)
+
+	cached := NewDeviceClient(client, Settings{})
+	lookup := func() {
+		res, err := cached.DeviceByName(context.Background(), name)
+		require.NoError(t, err)
+		assert.Equal(t, device, res.Device)
+	}
+
+	lookup()
+	lookup()
+	client.AssertNumberOfCalls(t, "DeviceByName", 1)
+
+	_, err := cached.Update(context.Background(), []requests.UpdateDeviceRequest{{Device: dtos.UpdateDevice{Name: &name}}})
+	require.NoError(t, err)
+	lookup()
+	client.AssertNumberOfCalls(t, "DeviceByName", 2)
+
+	_, err = cached.DeleteDeviceByName(context.Background(), name)
+	require.NoError(t, err)
+	lookup()
+	client.AssertNumberOfCalls(t, "DeviceByName", 3)
+
+	assert.Equal(t, Metrics{Hits: 1, Misses: 3}, cached.Metrics())
+}
+
+func TestDeviceClientInvalidationById(t *testing.T) {
+	client := &mocks.DeviceClient{}
+	for _, name := range []string{"device1", "device2"} {
+		device := dtos.Device{Name: name, DBTimestamp: dtos.DBTimestamp{Modified: 1}}
+		client.On("DeviceByName", mock.Anything, name).Return(responses.DeviceResponse{Device: device}, nil)
+	}
+	client.On("Update", mock.Anything, mock.Anything).Return(nil, nil)
+
+	cached := NewDeviceClient(client, Settings{})
+	_, err := cached.DeviceByName(context.Background(), "device1")
+	require.NoError(t, err)
+	_, err = cached.DeviceByName(context.Background(), "device2")
+	require.NoError(t, err)
+
+	// the update renames the device identified by its id, its former name is unknown
+	id := "7a1707f0-166f-4c4b-bc9d-1d54c74e0137"
+	renamed := "renamed"
+	_, err = cached.Update(context.Background(), []requests.UpdateDeviceRequest{{Device: dtos.UpdateDevice{Id: &id, Name: &renamed}}})
+	require.NoError(t, err)
+	_, err = cached.DeviceByName(context.Background(), "device1")
+	require.NoError(t, err)
+	_, err = cached.DeviceByName(context.Background(), "device2")
+	require.NoError(t, err)
+	client.AssertNumberOfCalls(t, "DeviceByName", 4)
+}
@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// DeviceProfileClient caches the device profiles queried by name, the other methods are passed through to the wrapped
+// client
+type DeviceProfileClient struct {
+	interfaces.DeviceProfileClient
+	*store
+}
+
+// NewDeviceProfileClient wraps the DeviceProfileClient with a cache of the device profiles queried by name
+func NewDeviceProfileClient(client interfaces.DeviceProfileClient, settings Settings) *DeviceProfileClient {
+	return &DeviceProfileClient{
+		DeviceProfileClient: client,
+		store:               newStore(settings),
+	}
+}
+
+// DeviceProfileByName returns the cached device profile, querying or revalidating it if needed
+func (c *DeviceProfileClient) DeviceProfileByName(ctx context.Context, name string) (responses.DeviceProfileResponse, errors.EdgeX) {
+	value, err := c.get(ctx, name, func(ctx context.Context) (interface{}, int64, errors.EdgeX) {
+		res, err := c.DeviceProfileClient.DeviceProfileByName(ctx, name)
+		return res, res.Profile.Modified, err
+	})
+	if err != nil {
+		return responses.DeviceProfileResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return value.(responses.DeviceProfileResponse), nil
+}
+
+// Update updates the device profiles and invalidates them
+func (c *DeviceProfileClient) Update(ctx context.Context, reqs []requests.DeviceProfileRequest) ([]common.BaseResponse, errors.EdgeX) {
+	res, err := c.DeviceProfileClient.Update(ctx, reqs)
+	ids := make([]*string, len(reqs))
+	names := make([]*string, len(reqs))
+	for i := range reqs {
+		ids[i], names[i] = &reqs[i].Profile.Id, &reqs[i].Profile.Name
+	}
+	c.invalidateUpdated(ids, names)
+	return res, err
+}
+
+// UpdateByYaml updates the device profile of the file and invalidates all the device profiles, since the name of the
+// profile isn't known without parsing the file
+func (c *DeviceProfileClient) UpdateByYaml(ctx context.Context, yamlFilePath string) (common.BaseResponse, errors.EdgeX) {
+	res, err := c.DeviceProfileClient.UpdateByYaml(ctx, yamlFilePath)
+	c.InvalidateAll()
+	return res, err
+}
+
+// DeleteByName deletes the device profile and invalidates it
+func (c *DeviceProfileClient) DeleteByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	res, err := c.DeviceProfileClient.DeleteByName(ctx, name)
+	c.Invalidate(name)
+	return res, err
+}
@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	clients "github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceProfileClientRevalidation(t *testing.T) {
+	profile := dtos.DeviceProfile{Name: "testProfile", DBTimestamp: dtos.DBTimestamp{Modified: 1609459200000}}
+	var queries, notModified int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.EscapedPath() == path.Join(common.ApiDeviceProfileRoute, common.Name, profile.Name):
+			atomic.AddInt32(&queries, 1)
+			if r.Header.Get("If-None-Match") == ETag(profile.Modified) {
+				atomic.AddInt32(&notModified, 1)
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			res, _ := json.Marshal(responses.NewDeviceProfileResponse("", "", http.StatusOK, profile))
+			_, _ = w.Write(res)
+		case r.Method == http.MethodPut && r.URL.EscapedPath() == common.ApiDeviceProfileRoute:
+			profile.Modified++
+			w.WriteHeader(http.StatusMultiStatus)
+			_, _ = w.Write([]byte("[]"))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewDeviceProfileClient(clients.NewDeviceProfileClient(ts.URL), Settings{TTL: 20 * time.Millisecond})
+	lookup := func() {
+		res, err := client.DeviceProfileByName(context.Background(), profile.Name)
+		require.NoError(t, err)
+		assert.Equal(t, profile.Name, res.Profile.Name)
+	}
+
+	lookup()
+	lookup()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&queries))
+
+	time.Sleep(25 * time.Millisecond)
+	lookup()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&queries))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&notModified))
+
+	_, err := client.Update(context.Background(), []requests.DeviceProfileRequest{{Profile: profile}})
+	require.NoError(t, err)
+	lookup()
+	assert.Equal(t, int32(3), atomic.LoadInt32(&queries))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&notModified))
+
+	assert.Equal(t, Metrics{Hits: 2, Misses: 2, Revalidations: 1}, client.Metrics())
+}
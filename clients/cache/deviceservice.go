@@ -0,0 +1,62 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// DeviceServiceClient caches the device services queried by name, the other methods are passed through to the wrapped
+// client
+type DeviceServiceClient struct {
+	interfaces.DeviceServiceClient
+	*store
+}
+
+// NewDeviceServiceClient wraps the DeviceServiceClient with a cache of the device services queried by name
+func NewDeviceServiceClient(client interfaces.DeviceServiceClient, settings Settings) *DeviceServiceClient {
+	return &DeviceServiceClient{
+		DeviceServiceClient: client,
+		store:               newStore(settings),
+	}
+}
+
+// DeviceServiceByName returns the cached device service, querying or revalidating it if needed
+func (c *DeviceServiceClient) DeviceServiceByName(ctx context.Context, name string) (responses.DeviceServiceResponse, errors.EdgeX) {
+	value, err := c.get(ctx, name, func(ctx context.Context) (interface{}, int64, errors.EdgeX) {
+		res, err := c.DeviceServiceClient.DeviceServiceByName(ctx, name)
+		return res, res.Service.Modified, err
+	})
+	if err != nil {
+		return responses.DeviceServiceResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return value.(responses.DeviceServiceResponse), nil
+}
+
+// Update updates the device services and invalidates them
+func (c *DeviceServiceClient) Update(ctx context.Context, reqs []requests.UpdateDeviceServiceRequest) ([]common.BaseResponse, errors.EdgeX) {
+	res, err := c.DeviceServiceClient.Update(ctx, reqs)
+	ids := make([]*string, len(reqs))
+	names := make([]*string, len(reqs))
+	for i, req := range reqs {
+		ids[i], names[i] = req.Service.Id, req.Service.Name
+	}
+	c.invalidateUpdated(ids, names)
+	return res, err
+}
+
+// DeleteByName deletes the device service and invalidates it
+func (c *DeviceServiceClient) DeleteByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	res, err := c.DeviceServiceClient.DeleteByName(ctx, name)
+	c.Invalidate(name)
+	return res, err
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conformance provides the behavioral test suites shared by the implementations of the client interfaces, so
+// that an implementation over another transport, e.g. a message bus, can be checked against the semantics of the HTTP
+// clients. Each suite is run from a regular test with a constructor of the client under test:
+//
+//	func TestDeviceClient(t *testing.T) {
+//		conformance.RunDeviceClientSuite(t, func(t *testing.T) interfaces.DeviceClient {
+//			return newBusDeviceClient(t)
+//		})
+//	}
+//
+// The constructor is called once per test of the suite, and must return a client backed by an empty store. The suites
+// cover the round-trips of the entities, paging, duplicate names, the error kinds and the label filters. Both the HTTP
+// clients, backed by the refserver package, and the in-memory clients of the fakes package pass the suites.
+package conformance
+
+import (
+	"net/http"
+	"sort"
+	"testing"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// requireKind checks that err is an EdgeX error of the expected kind
+func requireKind(t *testing.T, expected errors.ErrKind, err errors.EdgeX) {
+	t.Helper()
+	require.Error(t, err, "expected a %s error", expected)
+	assert.Equal(t, expected, errors.Kind(err), "unexpected error kind: %s", err.Error())
+}
+
+// requireAdded checks that all the items of an Add response are created, and returns their ids
+func requireAdded(t *testing.T, res []dtoCommon.BaseWithIdResponse, err errors.EdgeX, count int) []string {
+	t.Helper()
+	require.NoError(t, err)
+	require.Len(t, res, count)
+	ids := make([]string, count)
+	for i, item := range res {
+		require.Equal(t, http.StatusCreated, item.StatusCode, "item %d: %s", i, item.Message)
+		require.NotEmpty(t, item.Id, "item %d has no id", i)
+		ids[i] = item.Id
+	}
+	return ids
+}
+
+// sorted returns a sorted copy of the strings, the order of the entities of a response being left to the implementation
+// unless specified otherwise
+func sorted(values []string) []string {
+	result := append([]string(nil), values...)
+	sort.Strings(result)
+	return result
+}
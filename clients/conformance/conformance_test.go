@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/refserver"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newReferenceServer starts a reference server backed by an empty store, closed at the end of the test
+func newReferenceServer(t *testing.T) string {
+	ts := httptest.NewServer(refserver.NewServer(refserver.Store{}))
+	t.Cleanup(ts.Close)
+	return ts.URL
+}
+
+func TestSorted(t *testing.T) {
+	values := []string{"b", "c", "a"}
+	assert.Equal(t, []string{"a", "b", "c"}, sorted(values))
+	assert.Equal(t, []string{"b", "c", "a"}, values, "the values are left unchanged")
+	assert.Nil(t, sorted(nil))
+}
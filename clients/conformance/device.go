@@ -0,0 +1,209 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// NewDeviceClientFunc creates the DeviceClient under test, backed by an empty store
+type NewDeviceClientFunc func(t *testing.T) interfaces.DeviceClient
+
+// RunDeviceClientSuite runs the behavioral tests of the DeviceClient interface against the clients created by newClient
+func RunDeviceClientSuite(t *testing.T, newClient NewDeviceClientFunc) {
+	t.Run("AddAndGetByName", func(t *testing.T) {
+		client := newClient(t)
+		ids := mustAddDevices(t, client, newDevice("device1", "profile1", "service1", "label1"))
+
+		res, err := client.DeviceByName(context.Background(), "device1")
+		require.NoError(t, err)
+		assert.Equal(t, ids[0], res.Device.Id)
+		assert.Equal(t, "device1", res.Device.Name)
+		assert.Equal(t, "profile1", res.Device.ProfileName)
+		assert.Equal(t, "service1", res.Device.ServiceName)
+		assert.Equal(t, []string{"label1"}, res.Device.Labels)
+		assert.Equal(t, models.Unlocked, res.Device.AdminState)
+		_, err = client.DeviceNameExists(context.Background(), "device1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("DuplicateName", func(t *testing.T) {
+		client := newClient(t)
+		mustAddDevices(t, client, newDevice("device1", "profile1", "service1"))
+
+		res, err := addDevices(client, newDevice("device1", "profile2", "service1"), newDevice("device2", "profile1", "service1"))
+		require.NoError(t, err, "a duplicate name fails the item, not the batch")
+		require.Len(t, res, 2)
+		assert.Equal(t, http.StatusConflict, res[0].StatusCode)
+		assert.Equal(t, http.StatusCreated, res[1].StatusCode)
+		device, err := client.DeviceByName(context.Background(), "device1")
+		require.NoError(t, err)
+		assert.Equal(t, "profile1", device.Device.ProfileName, "the duplicate doesn't replace the existing device")
+	})
+
+	t.Run("InvalidRequest", func(t *testing.T) {
+		client := newClient(t)
+		_, err := addDevices(client, dtos.Device{Name: "invalid"})
+		requireKind(t, errors.KindContractInvalid, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		client := newClient(t)
+		_, err := client.DeviceByName(context.Background(), "missing")
+		requireKind(t, errors.KindEntityDoesNotExist, err)
+		_, err = client.DeviceNameExists(context.Background(), "missing")
+		requireKind(t, errors.KindEntityDoesNotExist, err)
+		_, err = client.DeleteDeviceByName(context.Background(), "missing")
+		requireKind(t, errors.KindEntityDoesNotExist, err)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		client := newClient(t)
+		ids := mustAddDevices(t, client, newDevice("device1", "profile1", "service1", "label1"))
+
+		name, missing, locked := "device1", "missing", models.Locked
+		res, err := client.Update(context.Background(), []requests.UpdateDeviceRequest{
+			requests.NewUpdateDeviceRequest(dtos.UpdateDevice{Name: &name, AdminState: &locked, Labels: []string{"label2"}}),
+			requests.NewUpdateDeviceRequest(dtos.UpdateDevice{Name: &missing, AdminState: &locked}),
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, http.StatusOK, res[0].StatusCode)
+		assert.Equal(t, http.StatusNotFound, res[1].StatusCode)
+
+		device, err := client.DeviceByName(context.Background(), "device1")
+		require.NoError(t, err)
+		assert.Equal(t, ids[0], device.Device.Id)
+		assert.Equal(t, models.Locked, device.Device.AdminState)
+		assert.Equal(t, []string{"label2"}, device.Device.Labels)
+		assert.Equal(t, "profile1", device.Device.ProfileName, "the fields not in the update are unchanged")
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		client := newClient(t)
+		mustAddDevices(t, client, newDevice("device1", "profile1", "service1"), newDevice("device2", "profile1", "service1"))
+
+		_, err := client.DeleteDeviceByName(context.Background(), "device1")
+		require.NoError(t, err)
+		_, err = client.DeviceByName(context.Background(), "device1")
+		requireKind(t, errors.KindEntityDoesNotExist, err)
+		_, err = client.DeviceByName(context.Background(), "device2")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Paging", func(t *testing.T) {
+		client := newClient(t)
+		var devices []dtos.Device
+		var names []string
+		for i := 0; i < 5; i++ {
+			devices = append(devices, newDevice(fmt.Sprintf("device%d", i), "profile1", "service1"))
+			names = append(names, devices[i].Name)
+		}
+		mustAddDevices(t, client, devices...)
+
+		var paged []string
+		for offset := 0; offset < 5; offset += 2 {
+			res, err := client.AllDevices(context.Background(), nil, offset, 2)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(5), res.TotalCount)
+			assert.LessOrEqual(t, len(res.Devices), 2)
+			for _, device := range res.Devices {
+				paged = append(paged, device.Name)
+			}
+		}
+		assert.Equal(t, names, sorted(paged), "the pages cover all the devices once")
+
+		all, err := client.AllDevices(context.Background(), nil, 0, -1)
+		require.NoError(t, err)
+		assert.Len(t, all.Devices, 5, "a negative limit returns all the devices")
+		_, err = client.AllDevices(context.Background(), nil, 6, 2)
+		requireKind(t, errors.KindRangeNotSatisfiable, err)
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		client := newClient(t)
+		mustAddDevices(t, client,
+			newDevice("device1", "profile1", "service1", "label1"),
+			newDevice("device2", "profile1", "service2", "label1", "label2"),
+			newDevice("device3", "profile2", "service2"),
+		)
+
+		tests := []struct {
+			name          string
+			query         func() (responses.MultiDevicesResponse, errors.EdgeX)
+			expectedNames []string
+		}{
+			{"label", func() (responses.MultiDevicesResponse, errors.EdgeX) {
+				return client.AllDevices(context.Background(), []string{"label1"}, 0, -1)
+			}, []string{"device1", "device2"}},
+			{"unused label", func() (responses.MultiDevicesResponse, errors.EdgeX) {
+				return client.AllDevices(context.Background(), []string{"unused"}, 0, -1)
+			}, nil},
+			{"profile name", func() (responses.MultiDevicesResponse, errors.EdgeX) {
+				return client.DevicesByProfileName(context.Background(), "profile1", 0, -1)
+			}, []string{"device1", "device2"}},
+			{"service name", func() (responses.MultiDevicesResponse, errors.EdgeX) {
+				return client.DevicesByServiceName(context.Background(), "service2", 0, -1)
+			}, []string{"device2", "device3"}},
+			{"unknown service name", func() (responses.MultiDevicesResponse, errors.EdgeX) {
+				return client.DevicesByServiceName(context.Background(), "unknown", 0, -1)
+			}, nil},
+		}
+		for _, testCase := range tests {
+			t.Run(testCase.name, func(t *testing.T) {
+				res, err := testCase.query()
+				require.NoError(t, err)
+				assert.Equal(t, uint32(len(testCase.expectedNames)), res.TotalCount)
+				var names []string
+				for _, device := range res.Devices {
+					names = append(names, device.Name)
+				}
+				assert.Equal(t, testCase.expectedNames, sorted(names))
+			})
+		}
+	})
+}
+
+// mustAddDevices adds the devices, which must all be created, and returns their ids
+func mustAddDevices(t *testing.T, client interfaces.DeviceClient, devices ...dtos.Device) []string {
+	t.Helper()
+	res, err := addDevices(client, devices...)
+	return requireAdded(t, res, err, len(devices))
+}
+
+func addDevices(client interfaces.DeviceClient, devices ...dtos.Device) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	reqs := make([]requests.AddDeviceRequest, len(devices))
+	for i, device := range devices {
+		reqs[i] = requests.NewAddDeviceRequest(device)
+	}
+	return client.Add(context.Background(), reqs)
+}
+
+func newDevice(name string, profileName string, serviceName string, labels ...string) dtos.Device {
+	return dtos.Device{
+		Name:           name,
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		Labels:         labels,
+		ServiceName:    serviceName,
+		ProfileName:    profileName,
+		Protocols:      map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": "localhost", "Port": "502"}},
+	}
+}
@@ -0,0 +1,160 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// NewDeviceServiceClientFunc creates the DeviceServiceClient under test, backed by an empty store
+type NewDeviceServiceClientFunc func(t *testing.T) interfaces.DeviceServiceClient
+
+// RunDeviceServiceClientSuite runs the behavioral tests of the DeviceServiceClient interface against the clients
+// created by newClient
+func RunDeviceServiceClientSuite(t *testing.T, newClient NewDeviceServiceClientFunc) {
+	t.Run("AddAndGetByName", func(t *testing.T) {
+		client := newClient(t)
+		ids := mustAddDeviceServices(t, client, newDeviceService("service1", "label1"))
+
+		res, err := client.DeviceServiceByName(context.Background(), "service1")
+		require.NoError(t, err)
+		assert.Equal(t, ids[0], res.Service.Id)
+		assert.Equal(t, "service1", res.Service.Name)
+		assert.Equal(t, "http://service1:59900", res.Service.BaseAddress)
+		assert.Equal(t, []string{"label1"}, res.Service.Labels)
+	})
+
+	t.Run("DuplicateName", func(t *testing.T) {
+		client := newClient(t)
+		mustAddDeviceServices(t, client, newDeviceService("service1"))
+
+		res, err := addDeviceServices(client, newDeviceService("service1"), newDeviceService("service2"))
+		require.NoError(t, err, "a duplicate name fails the item, not the batch")
+		require.Len(t, res, 2)
+		assert.Equal(t, http.StatusConflict, res[0].StatusCode)
+		assert.Equal(t, http.StatusCreated, res[1].StatusCode)
+	})
+
+	t.Run("InvalidRequest", func(t *testing.T) {
+		client := newClient(t)
+		_, err := addDeviceServices(client, dtos.DeviceService{Name: "invalid"})
+		requireKind(t, errors.KindContractInvalid, err)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		client := newClient(t)
+		_, err := client.DeviceServiceByName(context.Background(), "missing")
+		requireKind(t, errors.KindEntityDoesNotExist, err)
+		_, err = client.DeleteByName(context.Background(), "missing")
+		requireKind(t, errors.KindEntityDoesNotExist, err)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		client := newClient(t)
+		ids := mustAddDeviceServices(t, client, newDeviceService("service1", "label1"))
+
+		name, missing, description := "service1", "missing", "updated"
+		res, err := client.Update(context.Background(), []requests.UpdateDeviceServiceRequest{
+			requests.NewUpdateDeviceServiceRequest(dtos.UpdateDeviceService{Name: &name, Description: &description}),
+			requests.NewUpdateDeviceServiceRequest(dtos.UpdateDeviceService{Name: &missing, Description: &description}),
+		})
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, http.StatusOK, res[0].StatusCode)
+		assert.Equal(t, http.StatusNotFound, res[1].StatusCode)
+
+		service, err := client.DeviceServiceByName(context.Background(), "service1")
+		require.NoError(t, err)
+		assert.Equal(t, ids[0], service.Service.Id)
+		assert.Equal(t, "updated", service.Service.Description)
+		assert.Equal(t, []string{"label1"}, service.Service.Labels, "the fields not in the update are unchanged")
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		client := newClient(t)
+		mustAddDeviceServices(t, client, newDeviceService("service1"))
+
+		_, err := client.DeleteByName(context.Background(), "service1")
+		require.NoError(t, err)
+		_, err = client.DeviceServiceByName(context.Background(), "service1")
+		requireKind(t, errors.KindEntityDoesNotExist, err)
+	})
+
+	t.Run("PagingAndLabels", func(t *testing.T) {
+		client := newClient(t)
+		var services []dtos.DeviceService
+		var names, labelled []string
+		for i := 0; i < 5; i++ {
+			var labels []string
+			if i%2 == 0 {
+				labels = []string{"even"}
+				labelled = append(labelled, fmt.Sprintf("service%d", i))
+			}
+			services = append(services, newDeviceService(fmt.Sprintf("service%d", i), labels...))
+			names = append(names, services[i].Name)
+		}
+		mustAddDeviceServices(t, client, services...)
+
+		var paged []string
+		for offset := 0; offset < 5; offset += 2 {
+			res, err := client.AllDeviceServices(context.Background(), nil, offset, 2)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(5), res.TotalCount)
+			for _, service := range res.Services {
+				paged = append(paged, service.Name)
+			}
+		}
+		assert.Equal(t, names, sorted(paged), "the pages cover all the device services once")
+		_, err := client.AllDeviceServices(context.Background(), nil, 6, 2)
+		requireKind(t, errors.KindRangeNotSatisfiable, err)
+
+		res, err := client.AllDeviceServices(context.Background(), []string{"even"}, 0, -1)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(len(labelled)), res.TotalCount)
+		var filtered []string
+		for _, service := range res.Services {
+			filtered = append(filtered, service.Name)
+		}
+		assert.Equal(t, labelled, sorted(filtered))
+	})
+}
+
+// mustAddDeviceServices adds the device services, which must all be created, and returns their ids
+func mustAddDeviceServices(t *testing.T, client interfaces.DeviceServiceClient, services ...dtos.DeviceService) []string {
+	t.Helper()
+	res, err := addDeviceServices(client, services...)
+	return requireAdded(t, res, err, len(services))
+}
+
+func addDeviceServices(client interfaces.DeviceServiceClient, services ...dtos.DeviceService) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	reqs := make([]requests.AddDeviceServiceRequest, len(services))
+	for i, service := range services {
+		reqs[i] = requests.NewAddDeviceServiceRequest(service)
+	}
+	return client.Add(context.Background(), reqs)
+}
+
+func newDeviceService(name string, labels ...string) dtos.DeviceService {
+	return dtos.DeviceService{
+		Name:        name,
+		BaseAddress: fmt.Sprintf("http://%s:59900", name),
+		AdminState:  models.Unlocked,
+		Labels:      labels,
+	}
+}
@@ -0,0 +1,157 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// NewEventClientFunc creates the EventClient under test, backed by an empty store
+type NewEventClientFunc func(t *testing.T) interfaces.EventClient
+
+// RunEventClientSuite runs the behavioral tests of the EventClient interface against the clients created by newClient
+func RunEventClientSuite(t *testing.T, newClient NewEventClientFunc) {
+	t.Run("AddAndCount", func(t *testing.T) {
+		client := newClient(t)
+		mustAddEvents(t, client, newEvent("device1", 100, "temperature"), newEvent("device1", 300, "temperature"),
+			newEvent("device2", 200, "temperature"))
+
+		count, err := client.EventCount(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint32(3), count.Count)
+		count, err = client.EventCountByDeviceName(context.Background(), "device1")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), count.Count)
+		count, err = client.EventCountByDeviceName(context.Background(), "unknown")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(0), count.Count)
+	})
+
+	t.Run("InvalidRequest", func(t *testing.T) {
+		client := newClient(t)
+		_, err := client.Add(context.Background(), requests.NewAddEventRequest(newEvent("device1", 100)))
+		requireKind(t, errors.KindContractInvalid, err)
+	})
+
+	t.Run("OrderAndPaging", func(t *testing.T) {
+		client := newClient(t)
+		ids := mustAddEvents(t, client, newEvent("device1", 200, "temperature"), newEvent("device1", 300, "temperature"),
+			newEvent("device2", 100, "temperature"))
+
+		all, err := client.AllEvents(context.Background(), 0, -1)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{300, 200, 100}, eventOrigins(all), "the events are sorted by origin, latest first")
+		assert.Equal(t, ids[1], all.Events[0].Id)
+
+		var paged []int64
+		for offset := 0; offset < 3; offset += 2 {
+			res, err := client.AllEvents(context.Background(), offset, 2)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(3), res.TotalCount)
+			paged = append(paged, eventOrigins(res)...)
+		}
+		assert.Equal(t, []int64{300, 200, 100}, paged)
+		_, err = client.AllEvents(context.Background(), 4, 2)
+		requireKind(t, errors.KindRangeNotSatisfiable, err)
+	})
+
+	t.Run("Queries", func(t *testing.T) {
+		client := newClient(t)
+		mustAddEvents(t, client, newEvent("device1", 100, "temperature"), newEvent("device1", 200, "temperature"),
+			newEvent("device2", 300, "temperature"))
+
+		tests := []struct {
+			name            string
+			query           func() (responses.MultiEventsResponse, errors.EdgeX)
+			expectedOrigins []int64
+		}{
+			{"device name", func() (responses.MultiEventsResponse, errors.EdgeX) {
+				return client.EventsByDeviceName(context.Background(), "device1", 0, -1)
+			}, []int64{200, 100}},
+			{"unknown device name", func() (responses.MultiEventsResponse, errors.EdgeX) {
+				return client.EventsByDeviceName(context.Background(), "unknown", 0, -1)
+			}, nil},
+			{"time range with inclusive bounds", func() (responses.MultiEventsResponse, errors.EdgeX) {
+				return client.EventsByTimeRange(context.Background(), 200, 300, 0, -1)
+			}, []int64{300, 200}},
+		}
+		for _, testCase := range tests {
+			t.Run(testCase.name, func(t *testing.T) {
+				res, err := testCase.query()
+				require.NoError(t, err)
+				assert.Equal(t, uint32(len(testCase.expectedOrigins)), res.TotalCount)
+				assert.Equal(t, testCase.expectedOrigins, eventOrigins(res))
+			})
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		client := newClient(t)
+		mustAddEvents(t, client, newEvent("device1", 100, "temperature"), newEvent("device2", 200, "temperature"))
+
+		_, err := client.DeleteByAge(context.Background(), 3600000)
+		require.NoError(t, err)
+		count, err := client.EventCount(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), count.Count, "the events added less than an hour ago are kept")
+
+		_, err = client.DeleteByDeviceName(context.Background(), "device1")
+		require.NoError(t, err)
+		_, err = client.DeleteByDeviceName(context.Background(), "unknown")
+		require.NoError(t, err, "deleting the events of a device without event isn't an error")
+		all, err := client.AllEvents(context.Background(), 0, -1)
+		require.NoError(t, err)
+		require.Len(t, all.Events, 1)
+		assert.Equal(t, "device2", all.Events[0].DeviceName)
+	})
+}
+
+// mustAddEvents adds the events, which must all be created, and returns their ids
+func mustAddEvents(t *testing.T, client interfaces.EventClient, events ...dtos.Event) []string {
+	t.Helper()
+	ids := make([]string, len(events))
+	for i, event := range events {
+		res, err := client.Add(context.Background(), requests.NewAddEventRequest(event))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusCreated, res.StatusCode, res.Message)
+		require.NotEmpty(t, res.Id)
+		ids[i] = res.Id
+	}
+	return ids
+}
+
+// newEvent creates an event of the device with one Int32 reading per resource, the event and its readings having the
+// specified origin
+func newEvent(deviceName string, origin int64, resourceNames ...string) dtos.Event {
+	event := dtos.NewEvent("profile1", deviceName, "source1")
+	event.Origin = origin
+	for _, resourceName := range resourceNames {
+		reading, _ := dtos.NewSimpleReading("profile1", deviceName, resourceName, common.ValueTypeInt32, int32(origin))
+		reading.Origin = origin
+		event.Readings = append(event.Readings, reading)
+	}
+	return event
+}
+
+func eventOrigins(res responses.MultiEventsResponse) []int64 {
+	var origins []int64
+	for _, event := range res.Events {
+		origins = append(origins, event.Origin)
+	}
+	return origins
+}
@@ -0,0 +1,27 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/fakes"
+	clientsHttp "github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+)
+
+func TestEventClientConformance(t *testing.T) {
+	t.Run("HTTP", func(t *testing.T) {
+		RunEventClientSuite(t, func(t *testing.T) interfaces.EventClient {
+			return clientsHttp.NewEventClient(newReferenceServer(t))
+		})
+	})
+	t.Run("Fake", func(t *testing.T) {
+		RunEventClientSuite(t, func(t *testing.T) interfaces.EventClient {
+			return fakes.NewEventClient()
+		})
+	})
+}
@@ -0,0 +1,132 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// NewReadingClientFunc creates the ReadingClient under test, backed by an empty store, and the EventClient adding the
+// events of that store
+type NewReadingClientFunc func(t *testing.T) (interfaces.EventClient, interfaces.ReadingClient)
+
+// RunReadingClientSuite runs the behavioral tests of the ReadingClient interface against the clients created by
+// newClients. The readings of the tests are added with the events:
+//
+//	device1, origin 100: temperature, humidity
+//	device1, origin 200: temperature
+//	device2, origin 300: temperature
+func RunReadingClientSuite(t *testing.T, newClients NewReadingClientFunc) {
+	newSeededClient := func(t *testing.T) interfaces.ReadingClient {
+		events, readings := newClients(t)
+		mustAddEvents(t, events, newEvent("device1", 100, "temperature", "humidity"),
+			newEvent("device1", 200, "temperature"), newEvent("device2", 300, "temperature"))
+		return readings
+	}
+
+	t.Run("Count", func(t *testing.T) {
+		client := newSeededClient(t)
+		count, err := client.ReadingCount(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint32(4), count.Count)
+		count, err = client.ReadingCountByDeviceName(context.Background(), "device1")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(3), count.Count)
+		count, err = client.ReadingCountByDeviceName(context.Background(), "unknown")
+		require.NoError(t, err)
+		assert.Equal(t, uint32(0), count.Count)
+	})
+
+	t.Run("OrderAndPaging", func(t *testing.T) {
+		client := newSeededClient(t)
+		all, err := client.AllReadings(context.Background(), 0, -1)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{300, 200, 100, 100}, readingOrigins(all), "the readings are sorted by origin, latest first")
+
+		var paged []string
+		for offset := 0; offset < 4; offset += 3 {
+			res, err := client.AllReadings(context.Background(), offset, 3)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(4), res.TotalCount)
+			for _, reading := range res.Readings {
+				paged = append(paged, reading.Id)
+			}
+		}
+		assert.Len(t, paged, 4)
+		for _, reading := range all.Readings {
+			assert.Contains(t, paged, reading.Id, "the pages cover all the readings once")
+		}
+		_, err = client.AllReadings(context.Background(), 5, 3)
+		requireKind(t, errors.KindRangeNotSatisfiable, err)
+	})
+
+	t.Run("Queries", func(t *testing.T) {
+		client := newSeededClient(t)
+		tests := []struct {
+			name      string
+			query     func() (responses.MultiReadingsResponse, errors.EdgeX)
+			expected  []int64
+			resources []string
+		}{
+			{"device name", func() (responses.MultiReadingsResponse, errors.EdgeX) {
+				return client.ReadingsByDeviceName(context.Background(), "device1", 0, -1)
+			}, []int64{200, 100, 100}, []string{"humidity", "temperature", "temperature"}},
+			{"unknown device name", func() (responses.MultiReadingsResponse, errors.EdgeX) {
+				return client.ReadingsByDeviceName(context.Background(), "unknown", 0, -1)
+			}, nil, nil},
+			{"resource name", func() (responses.MultiReadingsResponse, errors.EdgeX) {
+				return client.ReadingsByResourceName(context.Background(), "humidity", 0, -1)
+			}, []int64{100}, []string{"humidity"}},
+			{"time range with inclusive bounds", func() (responses.MultiReadingsResponse, errors.EdgeX) {
+				return client.ReadingsByTimeRange(context.Background(), 100, 200, 0, -1)
+			}, []int64{200, 100, 100}, []string{"humidity", "temperature", "temperature"}},
+			{"resource name and time range", func() (responses.MultiReadingsResponse, errors.EdgeX) {
+				return client.ReadingsByResourceNameAndTimeRange(context.Background(), "temperature", 150, 300, 0, -1)
+			}, []int64{300, 200}, []string{"temperature", "temperature"}},
+			{"device and resource names", func() (responses.MultiReadingsResponse, errors.EdgeX) {
+				return client.ReadingsByDeviceNameAndResourceName(context.Background(), "device1", "temperature", 0, -1)
+			}, []int64{200, 100}, []string{"temperature", "temperature"}},
+			{"device and resource names and time range", func() (responses.MultiReadingsResponse, errors.EdgeX) {
+				return client.ReadingsByDeviceNameAndResourceNameAndTimeRange(context.Background(), "device1", "temperature", 150, 300, 0, -1)
+			}, []int64{200}, []string{"temperature"}},
+			{"device name and resource names and time range", func() (responses.MultiReadingsResponse, errors.EdgeX) {
+				return client.ReadingsByDeviceNameAndResourceNamesAndTimeRange(context.Background(), "device1", []string{"humidity"}, 0, 1000, 0, -1)
+			}, []int64{100}, []string{"humidity"}},
+			{"device name and no resource names and time range", func() (responses.MultiReadingsResponse, errors.EdgeX) {
+				return client.ReadingsByDeviceNameAndResourceNamesAndTimeRange(context.Background(), "device1", nil, 0, 1000, 0, -1)
+			}, []int64{200, 100, 100}, []string{"humidity", "temperature", "temperature"}},
+		}
+		for _, testCase := range tests {
+			t.Run(testCase.name, func(t *testing.T) {
+				res, err := testCase.query()
+				require.NoError(t, err)
+				assert.Equal(t, uint32(len(testCase.expected)), res.TotalCount)
+				assert.Equal(t, testCase.expected, readingOrigins(res))
+				var resources []string
+				for _, reading := range res.Readings {
+					resources = append(resources, reading.ResourceName)
+				}
+				assert.Equal(t, testCase.resources, sorted(resources))
+			})
+		}
+	})
+}
+
+func readingOrigins(res responses.MultiReadingsResponse) []int64 {
+	var origins []int64
+	for _, reading := range res.Readings {
+		origins = append(origins, reading.Origin)
+	}
+	return origins
+}
@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/fakes"
+	clientsHttp "github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+)
+
+func TestReadingClientConformance(t *testing.T) {
+	t.Run("HTTP", func(t *testing.T) {
+		RunReadingClientSuite(t, func(t *testing.T) (interfaces.EventClient, interfaces.ReadingClient) {
+			baseUrl := newReferenceServer(t)
+			return clientsHttp.NewEventClient(baseUrl), clientsHttp.NewReadingClient(baseUrl)
+		})
+	})
+	t.Run("Fake", func(t *testing.T) {
+		RunReadingClientSuite(t, func(t *testing.T) (interfaces.EventClient, interfaces.ReadingClient) {
+			events := fakes.NewEventClient()
+			return events, fakes.NewReadingClient(events)
+		})
+	})
+}
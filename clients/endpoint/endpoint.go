@@ -0,0 +1,136 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package endpoint provides the implementations of interfaces.EndpointResolver: a static base URL, a base URL looked
+// up in a file, and a base URL looked up in the service registry. The looked up base URL is cached and looked up
+// again periodically, so that the clients follow a service moving to another host.
+package endpoint
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const (
+	// defaultRefreshInterval is the interval of the Service Registry monitoring of the service clients
+	defaultRefreshInterval = common.ClientMonitorDefault * time.Millisecond
+	// lookupTimeout bounds a lookup, which isn't bound to the context of any single caller
+	lookupTimeout = 30 * time.Second
+)
+
+type staticResolver struct {
+	baseUrl string
+}
+
+// NewStaticResolver creates an EndpointResolver always returning the specified base URL
+func NewStaticResolver(baseUrl string) interfaces.EndpointResolver {
+	return &staticResolver{baseUrl: baseUrl}
+}
+
+func (s *staticResolver) ResolveEndpoint(_ context.Context) (string, error) {
+	return s.baseUrl, nil
+}
+
+// LookupFunc looks up the current base URL of a service
+type LookupFunc func(ctx context.Context) (string, error)
+
+// RefreshingResolver caches the base URL returned by a LookupFunc and looks it up again once the refresh interval has
+// elapsed. When the lookup fails, the last base URL found keeps being used until the next refresh. The lookup is made
+// without holding the lock of the resolver and only once at a time: the cached base URL keeps being served while it is
+// refreshed, and the callers waiting for the first base URL share the same lookup, which isn't canceled when one of
+// them gives up.
+type RefreshingResolver struct {
+	lookup      LookupFunc
+	interval    time.Duration
+	baseUrl     string
+	refreshedAt time.Time
+	inflight    *lookupCall
+	mutex       sync.Mutex
+}
+
+// lookupCall is a lookup in progress, done being closed once err is set
+type lookupCall struct {
+	done chan struct{}
+	err  errors.EdgeX
+}
+
+// NewRefreshingResolver creates a RefreshingResolver looking up the base URL with the specified function. The base URL
+// is looked up again every interval, which defaults to common.ClientMonitorDefault milliseconds.
+func NewRefreshingResolver(lookup LookupFunc, interval time.Duration) *RefreshingResolver {
+	if interval <= 0 {
+		interval = defaultRefreshInterval
+	}
+	return &RefreshingResolver{lookup: lookup, interval: interval}
+}
+
+// ResolveEndpoint returns the cached base URL, looking it up first if the refresh interval has elapsed and no other
+// caller is refreshing it already
+func (rr *RefreshingResolver) ResolveEndpoint(ctx context.Context) (string, error) {
+	rr.mutex.Lock()
+	baseUrl := rr.baseUrl
+	current := time.Since(rr.refreshedAt) < rr.interval || rr.inflight != nil
+	rr.mutex.Unlock()
+	if baseUrl != "" && current {
+		return baseUrl, nil
+	}
+
+	err := rr.refresh(ctx)
+	rr.mutex.Lock()
+	baseUrl = rr.baseUrl
+	rr.mutex.Unlock()
+	if err != nil && baseUrl == "" {
+		return "", err
+	}
+	return baseUrl, nil
+}
+
+// Refresh looks up the base URL immediately, e.g. when the service can't be reached at the cached base URL
+func (rr *RefreshingResolver) Refresh(ctx context.Context) errors.EdgeX {
+	return rr.refresh(ctx)
+}
+
+// refresh looks up the base URL, or waits for the lookup already in progress. The lookup isn't canceled with the
+// context, which only stops the caller from waiting, since the other callers may still wait for the base URL.
+func (rr *RefreshingResolver) refresh(ctx context.Context) errors.EdgeX {
+	rr.mutex.Lock()
+	call := rr.inflight
+	if call == nil {
+		call = &lookupCall{done: make(chan struct{})}
+		rr.inflight = call
+		// A failed lookup is not retried before the next interval, so that an unavailable registry isn't hammered
+		rr.refreshedAt = time.Now()
+		go rr.lookUp(call)
+	}
+	rr.mutex.Unlock()
+
+	select {
+	case <-call.done:
+		return call.err
+	case <-ctx.Done():
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "failed to look up the base URL of the service", ctx.Err())
+	}
+}
+
+// lookUp looks up the base URL and caches it
+func (rr *RefreshingResolver) lookUp(call *lookupCall) {
+	ctx, cancel := context.WithTimeout(context.Background(), lookupTimeout)
+	defer cancel()
+	baseUrl, err := rr.lookup(ctx)
+	if err != nil {
+		call.err = errors.NewCommonEdgeX(errors.KindServiceUnavailable, "failed to look up the base URL of the service", err)
+	}
+	rr.mutex.Lock()
+	if err == nil {
+		rr.baseUrl = baseUrl
+	}
+	rr.inflight = nil
+	rr.mutex.Unlock()
+	close(call.done)
+}
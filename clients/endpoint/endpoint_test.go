@@ -0,0 +1,224 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoint
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticResolver(t *testing.T) {
+	baseUrl, err := NewStaticResolver("http://localhost:59880").ResolveEndpoint(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:59880", baseUrl)
+}
+
+func TestRefreshingResolver(t *testing.T) {
+	var lookups int
+	var lookupErr error
+	resolver := NewRefreshingResolver(func(_ context.Context) (string, error) {
+		lookups++
+		return fmt.Sprintf("http://host%d:59880", lookups), lookupErr
+	}, time.Hour)
+
+	baseUrl, err := resolver.ResolveEndpoint(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "http://host1:59880", baseUrl)
+
+	// the base URL is cached until the refresh interval elapses
+	baseUrl, err = resolver.ResolveEndpoint(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "http://host1:59880", baseUrl)
+	assert.Equal(t, 1, lookups)
+
+	require.NoError(t, resolver.Refresh(context.Background()))
+	baseUrl, _ = resolver.ResolveEndpoint(context.Background())
+	assert.Equal(t, "http://host2:59880", baseUrl)
+
+	// the last base URL found keeps being used when the lookup fails
+	lookupErr = fmt.Errorf("registry unavailable")
+	err = resolver.Refresh(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	baseUrl, err = resolver.ResolveEndpoint(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "http://host2:59880", baseUrl)
+}
+
+func TestRefreshingResolverServesStaleBaseUrlDuringRefresh(t *testing.T) {
+	release := make(chan struct{})
+	var lookups int32
+	resolver := NewRefreshingResolver(func(_ context.Context) (string, error) {
+		if atomic.AddInt32(&lookups, 1) > 1 {
+			<-release
+		}
+		return fmt.Sprintf("http://host%d:59880", atomic.LoadInt32(&lookups)), nil
+	}, time.Millisecond)
+
+	baseUrl, err := resolver.ResolveEndpoint(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "http://host1:59880", baseUrl)
+	time.Sleep(2 * time.Millisecond)
+
+	// the refresh is blocked in the lookup, the other callers are served the stale base URL without waiting
+	refreshed := make(chan string)
+	go func() {
+		baseUrl, _ := resolver.ResolveEndpoint(context.Background())
+		refreshed <- baseUrl
+	}()
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&lookups) == 2 }, time.Second, time.Millisecond)
+	for i := 0; i < 3; i++ {
+		baseUrl, err = resolver.ResolveEndpoint(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "http://host1:59880", baseUrl)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&lookups), "a single lookup is made at a time")
+
+	close(release)
+	assert.Equal(t, "http://host2:59880", <-refreshed)
+}
+
+func TestRefreshingResolverSharesFirstLookup(t *testing.T) {
+	release := make(chan struct{})
+	var lookups int32
+	resolver := NewRefreshingResolver(func(_ context.Context) (string, error) {
+		atomic.AddInt32(&lookups, 1)
+		<-release
+		return "http://host:59880", nil
+	}, time.Hour)
+
+	var wg sync.WaitGroup
+	baseUrls := make([]string, 5)
+	for i := range baseUrls {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			baseUrls[i], _ = resolver.ResolveEndpoint(context.Background())
+		}(i)
+	}
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&lookups) == 1 }, time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&lookups))
+	for _, baseUrl := range baseUrls {
+		assert.Equal(t, "http://host:59880", baseUrl)
+	}
+
+	// a caller giving up doesn't wait for the lookup in progress
+	unblock := make(chan struct{})
+	defer close(unblock)
+	var blockedLookups int32
+	blocked := NewRefreshingResolver(func(_ context.Context) (string, error) {
+		atomic.AddInt32(&blockedLookups, 1)
+		<-unblock
+		return "http://host:59880", nil
+	}, time.Hour)
+	go func() { _, _ = blocked.ResolveEndpoint(context.Background()) }()
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&blockedLookups) == 1 }, time.Second, time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := blocked.ResolveEndpoint(ctx)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+}
+
+func TestRefreshingResolverLookupOutlivesFirstCaller(t *testing.T) {
+	release := make(chan struct{})
+	var lookups int32
+	resolver := NewRefreshingResolver(func(ctx context.Context) (string, error) {
+		atomic.AddInt32(&lookups, 1)
+		select {
+		case <-release:
+			return "http://host:59880", nil
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}, time.Hour)
+
+	// the caller starting the lookup gives up, the lookup keeps going for the other callers
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := resolver.ResolveEndpoint(ctx)
+		first <- err
+	}()
+	require.Eventually(t, func() bool {
+		resolver.mutex.Lock()
+		defer resolver.mutex.Unlock()
+		return resolver.inflight != nil
+	}, time.Second, time.Millisecond)
+	waiter := make(chan string)
+	go func() {
+		baseUrl, _ := resolver.ResolveEndpoint(context.Background())
+		waiter <- baseUrl
+	}()
+	cancel()
+	require.Error(t, <-first)
+
+	close(release)
+	assert.Equal(t, "http://host:59880", <-waiter)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&lookups))
+}
+
+func TestFileResolver(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "endpoints.yaml")
+	require.NoError(t, ioutil.WriteFile(filePath, []byte("core-data: http://edgex-core-data:59880\n"), 0600))
+
+	resolver := NewFileResolver(filePath, common.CoreDataServiceKey, time.Millisecond)
+	baseUrl, err := resolver.ResolveEndpoint(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "http://edgex-core-data:59880", baseUrl)
+
+	// the service moved to another host
+	require.NoError(t, ioutil.WriteFile(filePath, []byte(`{"core-data": "http://10.0.0.2:59880"}`), 0600))
+	time.Sleep(5 * time.Millisecond)
+	baseUrl, err = resolver.ResolveEndpoint(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "http://10.0.0.2:59880", baseUrl)
+
+	_, err = NewFileResolver(filePath, common.CoreMetaDataServiceKey, 0).ResolveEndpoint(context.Background())
+	require.Error(t, err)
+	_, err = NewFileResolver(filepath.Join(os.TempDir(), "missing.yaml"), common.CoreDataServiceKey, 0).ResolveEndpoint(context.Background())
+	require.Error(t, err)
+}
+
+type testRegistry map[string]ServiceEndpoint
+
+func (r testRegistry) GetServiceEndpoint(serviceKey string) (ServiceEndpoint, error) {
+	endpoint, ok := r[serviceKey]
+	if !ok {
+		return ServiceEndpoint{}, fmt.Errorf("%s not registered", serviceKey)
+	}
+	return endpoint, nil
+}
+
+func TestRegistryResolver(t *testing.T) {
+	registry := testRegistry{common.CoreDataServiceKey: {ServiceId: common.CoreDataServiceKey, Host: "edgex-core-data", Port: 59880}}
+
+	baseUrl, err := NewRegistryResolver(registry, common.CoreDataServiceKey, "", 0).ResolveEndpoint(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "http://edgex-core-data:59880", baseUrl)
+
+	baseUrl, err = NewRegistryResolver(registry, common.CoreDataServiceKey, "https", 0).ResolveEndpoint(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "https://edgex-core-data:59880", baseUrl)
+
+	_, err = NewRegistryResolver(registry, common.CoreMetaDataServiceKey, "", 0).ResolveEndpoint(context.Background())
+	require.Error(t, err)
+}
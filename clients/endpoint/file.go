@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoint
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"gopkg.in/yaml.v3"
+)
+
+// NewFileResolver creates a RefreshingResolver looking up the base URL of the service identified by serviceKey in a
+// JSON or YAML file mapping the service keys to their base URL, e.g.
+//
+//	core-data: http://edgex-core-data:59880
+//	core-metadata: http://edgex-core-metadata:59881
+//
+// The file is read again every refreshInterval, so that it can be updated while the clients are running.
+func NewFileResolver(filePath string, serviceKey string, refreshInterval time.Duration) *RefreshingResolver {
+	return NewRefreshingResolver(func(_ context.Context) (string, error) {
+		return lookupFile(filePath, serviceKey)
+	}, refreshInterval)
+}
+
+func lookupFile(filePath string, serviceKey string) (string, errors.EdgeX) {
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("failed to read the endpoint file %s", filePath), err)
+	}
+	endpoints := make(map[string]string)
+	if err := yaml.Unmarshal(contents, &endpoints); err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the endpoint file %s", filePath), err)
+	}
+	baseUrl, ok := endpoints[serviceKey]
+	if !ok || baseUrl == "" {
+		return "", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no endpoint of %s in the endpoint file %s", serviceKey, filePath), nil)
+	}
+	return baseUrl, nil
+}
@@ -0,0 +1,49 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoint
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+)
+
+const defaultScheme = "http"
+
+// ServiceEndpoint is the location of a service registered in the service registry
+type ServiceEndpoint struct {
+	ServiceId string
+	Host      string
+	Port      int
+}
+
+// Registry defines the interface of the service registry looked up by the registry resolver, e.g. an adapter of the
+// registry client of go-mod-registry.
+type Registry interface {
+	// GetServiceEndpoint returns the location of the service registered with the specified service key
+	GetServiceEndpoint(serviceKey string) (ServiceEndpoint, error)
+}
+
+// NewRegistryResolver creates a RefreshingResolver looking up the service identified by serviceKey, e.g.
+// common.CoreDataServiceKey, in the service registry. The base URL is built with the specified scheme, which defaults
+// to http, and is looked up again every refreshInterval.
+func NewRegistryResolver(registry Registry, serviceKey string, scheme string, refreshInterval time.Duration) *RefreshingResolver {
+	if scheme == "" {
+		scheme = defaultScheme
+	}
+	return NewRefreshingResolver(func(_ context.Context) (string, error) {
+		endpoint, err := registry.GetServiceEndpoint(serviceKey)
+		if err != nil {
+			return "", err
+		}
+		if endpoint.Host == "" || endpoint.Port <= 0 {
+			return "", fmt.Errorf("invalid endpoint %s:%d registered for %s", endpoint.Host, endpoint.Port, serviceKey)
+		}
+		return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(endpoint.Host, strconv.Itoa(endpoint.Port))), nil
+	}, refreshInterval)
+}
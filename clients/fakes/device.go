@@ -0,0 +1,139 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const deviceEntity = "device"
+
+// DeviceClient is an in-memory interfaces.DeviceClient
+type DeviceClient struct {
+	devices []dtos.Device
+	mutex   sync.RWMutex
+}
+
+// NewDeviceClient creates an in-memory DeviceClient holding the specified devices
+func NewDeviceClient(devices ...dtos.Device) *DeviceClient {
+	return &DeviceClient{devices: deepCopy(devices).([]dtos.Device)}
+}
+
+func (c *DeviceClient) Add(_ context.Context, reqs []requests.AddDeviceRequest) ([]common.BaseWithIdResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		device := req.Device
+		if c.indexOf(device.Name) >= 0 {
+			res[i] = addResponse(req.RequestId, "", duplicateName(deviceEntity, device.Name))
+			continue
+		}
+		device.Id = newId(device.Id)
+		device.Created = makeTimestamp()
+		device.Modified = device.Created
+		c.devices = append(c.devices, deepCopy(device).(dtos.Device))
+		res[i] = addResponse(req.RequestId, device.Id, nil)
+	}
+	return res, nil
+}
+
+func (c *DeviceClient) Update(_ context.Context, reqs []requests.UpdateDeviceRequest) ([]common.BaseResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		index, err := findForUpdate(deviceEntity, len(c.devices),
+			func(i int) string { return c.devices[i].Id }, func(i int) string { return c.devices[i].Name },
+			req.Device.Id, req.Device.Name)
+		if err == nil {
+			model := dtos.ToDeviceModel(c.devices[index])
+			requests.ReplaceDeviceModelFieldsWithDTO(&model, req.Device)
+			device := dtos.FromDeviceModelToDTO(model)
+			device.Modified = makeTimestamp()
+			c.devices[index] = deepCopy(device).(dtos.Device)
+		}
+		res[i] = updateResponse(req.RequestId, err)
+	}
+	return res, nil
+}
+
+func (c *DeviceClient) AllDevices(_ context.Context, labels []string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(device dtos.Device) bool { return hasAnyLabel(device.Labels, labels) })
+}
+
+func (c *DeviceClient) DeviceNameExists(_ context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if c.indexOf(name) < 0 {
+		return common.BaseResponse{}, notFound(deviceEntity, "name", name)
+	}
+	return okResponse(), nil
+}
+
+func (c *DeviceClient) DeviceByName(_ context.Context, name string) (responses.DeviceResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return responses.DeviceResponse{}, notFound(deviceEntity, "name", name)
+	}
+	return responses.NewDeviceResponse("", "", http.StatusOK, deepCopy(c.devices[i]).(dtos.Device)), nil
+}
+
+func (c *DeviceClient) DeleteDeviceByName(_ context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return common.BaseResponse{}, notFound(deviceEntity, "name", name)
+	}
+	c.devices = append(c.devices[:i:i], c.devices[i+1:]...)
+	return okResponse(), nil
+}
+
+func (c *DeviceClient) DevicesByProfileName(_ context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(device dtos.Device) bool { return device.ProfileName == name })
+}
+
+func (c *DeviceClient) DevicesByServiceName(_ context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(device dtos.Device) bool { return device.ServiceName == name })
+}
+
+func (c *DeviceClient) query(offset int, limit int, match func(dtos.Device) bool) (responses.MultiDevicesResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	var matched []dtos.Device
+	for _, device := range c.devices {
+		if match(device) {
+			matched = append(matched, device)
+		}
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return responses.MultiDevicesResponse{}, err
+	}
+	return responses.NewMultiDevicesResponse("", "", http.StatusOK, uint32(len(matched)), deepCopy(matched[start:end]).([]dtos.Device)), nil
+}
+
+// indexOf returns the position of the named device, the mutex must be held by the caller
+func (c *DeviceClient) indexOf(name string) int {
+	return indexOf(len(c.devices), func(i int) bool { return c.devices[i].Name == name })
+}
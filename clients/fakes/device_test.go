@@ -0,0 +1,124 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDevice(name string, profileName string, labels ...string) dtos.Device {
+	return dtos.Device{
+		Name:           name,
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		Labels:         labels,
+		ServiceName:    "testService",
+		ProfileName:    profileName,
+		Protocols:      map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": "localhost"}},
+	}
+}
+
+func TestDeviceClient(t *testing.T) {
+	client := NewDeviceClient(testDevice("existing", "profile1", "label1"))
+
+	res, err := client.Add(context.Background(), []requests.AddDeviceRequest{
+		requests.NewAddDeviceRequest(testDevice("device1", "profile1", "label1", "label2")),
+		requests.NewAddDeviceRequest(testDevice("existing", "profile1")),
+		requests.NewAddDeviceRequest(testDevice("device2", "profile2", "label2")),
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	assert.Equal(t, http.StatusCreated, res[0].StatusCode)
+	assert.NotEmpty(t, res[0].Id)
+	assert.Equal(t, http.StatusConflict, res[1].StatusCode)
+	assert.Equal(t, http.StatusCreated, res[2].StatusCode)
+
+	invalid := testDevice("invalid", "profile1")
+	invalid.AdminState = "invalid"
+	_, err = client.Add(context.Background(), []requests.AddDeviceRequest{requests.NewAddDeviceRequest(invalid)})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	all, err := client.AllDevices(context.Background(), nil, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), all.TotalCount)
+	labeled, err := client.AllDevices(context.Background(), []string{"label2"}, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), labeled.TotalCount)
+	require.Len(t, labeled.Devices, 1)
+	assert.Equal(t, "device2", labeled.Devices[0].Name)
+	byProfile, err := client.DevicesByProfileName(context.Background(), "profile1", 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, byProfile.Devices, 2)
+	_, err = client.DevicesByServiceName(context.Background(), "testService", 4, 10)
+	assert.Equal(t, errors.KindRangeNotSatisfiable, errors.Kind(err))
+
+	description := "updated"
+	name := "device1"
+	missing := "missing"
+	updates, err := client.Update(context.Background(), []requests.UpdateDeviceRequest{
+		requests.NewUpdateDeviceRequest(dtos.UpdateDevice{Name: &name, Description: &description}),
+		requests.NewUpdateDeviceRequest(dtos.UpdateDevice{Name: &missing, Description: &description}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, updates[0].StatusCode)
+	assert.Equal(t, http.StatusNotFound, updates[1].StatusCode)
+	device, err := client.DeviceByName(context.Background(), name)
+	require.NoError(t, err)
+	assert.Equal(t, description, device.Device.Description)
+	assert.Equal(t, []string{"label1", "label2"}, device.Device.Labels)
+
+	_, err = client.DeviceNameExists(context.Background(), name)
+	require.NoError(t, err)
+	_, err = client.DeleteDeviceByName(context.Background(), name)
+	require.NoError(t, err)
+	_, err = client.DeviceNameExists(context.Background(), name)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	_, err = client.DeviceByName(context.Background(), name)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	_, err = client.DeleteDeviceByName(context.Background(), name)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestDeviceClientIsolatesItsDevices(t *testing.T) {
+	device := testDevice("device1", "profile1", "label1")
+	client := NewDeviceClient(device)
+	device.Labels[0] = "changed"
+	device.Protocols["modbus-tcp"]["Address"] = "changed"
+
+	res, err := client.DeviceByName(context.Background(), "device1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"label1"}, res.Device.Labels)
+	assert.Equal(t, "localhost", res.Device.Protocols["modbus-tcp"]["Address"])
+	res.Device.Labels[0] = "changed"
+	res.Device.Protocols["modbus-tcp"]["Address"] = "changed"
+
+	all, err := client.AllDevices(context.Background(), nil, 0, -1)
+	require.NoError(t, err)
+	require.Len(t, all.Devices, 1)
+	assert.Equal(t, []string{"label1"}, all.Devices[0].Labels)
+	assert.Equal(t, "localhost", all.Devices[0].Protocols["modbus-tcp"]["Address"])
+	all.Devices[0].Labels[0] = "changed"
+
+	added := testDevice("device2", "profile1", "label2")
+	_, err = client.Add(context.Background(), []requests.AddDeviceRequest{requests.NewAddDeviceRequest(added)})
+	require.NoError(t, err)
+	added.Labels[0] = "changed"
+
+	all, err = client.AllDevices(context.Background(), []string{"label1", "label2"}, 0, -1)
+	require.NoError(t, err)
+	assert.Len(t, all.Devices, 2)
+}
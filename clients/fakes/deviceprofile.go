@@ -0,0 +1,205 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"gopkg.in/yaml.v3"
+)
+
+const deviceProfileEntity = "device profile"
+
+// DeviceProfileClient is an in-memory interfaces.DeviceProfileClient
+type DeviceProfileClient struct {
+	profiles []dtos.DeviceProfile
+	mutex    sync.RWMutex
+}
+
+// NewDeviceProfileClient creates an in-memory DeviceProfileClient holding the specified device profiles
+func NewDeviceProfileClient(profiles ...dtos.DeviceProfile) *DeviceProfileClient {
+	return &DeviceProfileClient{profiles: deepCopy(profiles).([]dtos.DeviceProfile)}
+}
+
+func (c *DeviceProfileClient) Add(_ context.Context, reqs []requests.DeviceProfileRequest) ([]common.BaseWithIdResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		id, err := c.add(req.Profile)
+		res[i] = addResponse(req.RequestId, id, err)
+	}
+	return res, nil
+}
+
+func (c *DeviceProfileClient) Update(_ context.Context, reqs []requests.DeviceProfileRequest) ([]common.BaseResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		res[i] = updateResponse(req.RequestId, c.update(req.Profile))
+	}
+	return res, nil
+}
+
+// AddByYaml adds the device profile of the YAML file
+func (c *DeviceProfileClient) AddByYaml(_ context.Context, yamlFilePath string) (common.BaseWithIdResponse, errors.EdgeX) {
+	profile, err := readProfileFile(yamlFilePath)
+	if err != nil {
+		return common.BaseWithIdResponse{}, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	id, err := c.add(profile)
+	if err != nil {
+		return common.BaseWithIdResponse{}, err
+	}
+	return addResponse("", id, nil), nil
+}
+
+// UpdateByYaml replaces the device profile of the same name as the one of the YAML file
+func (c *DeviceProfileClient) UpdateByYaml(_ context.Context, yamlFilePath string) (common.BaseResponse, errors.EdgeX) {
+	profile, err := readProfileFile(yamlFilePath)
+	if err != nil {
+		return common.BaseResponse{}, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if err = c.update(profile); err != nil {
+		return common.BaseResponse{}, err
+	}
+	return okResponse(), nil
+}
+
+func (c *DeviceProfileClient) DeleteByName(_ context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return common.BaseResponse{}, notFound(deviceProfileEntity, "name", name)
+	}
+	c.profiles = append(c.profiles[:i:i], c.profiles[i+1:]...)
+	return okResponse(), nil
+}
+
+func (c *DeviceProfileClient) DeviceProfileByName(_ context.Context, name string) (responses.DeviceProfileResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return responses.DeviceProfileResponse{}, notFound(deviceProfileEntity, "name", name)
+	}
+	return responses.NewDeviceProfileResponse("", "", http.StatusOK, deepCopy(c.profiles[i]).(dtos.DeviceProfile)), nil
+}
+
+func (c *DeviceProfileClient) AllDeviceProfiles(_ context.Context, labels []string, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(profile dtos.DeviceProfile) bool { return hasAnyLabel(profile.Labels, labels) })
+}
+
+func (c *DeviceProfileClient) DeviceProfilesByModel(_ context.Context, model string, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(profile dtos.DeviceProfile) bool { return profile.Model == model })
+}
+
+func (c *DeviceProfileClient) DeviceProfilesByManufacturer(_ context.Context, manufacturer string, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(profile dtos.DeviceProfile) bool { return profile.Manufacturer == manufacturer })
+}
+
+func (c *DeviceProfileClient) DeviceProfilesByManufacturerAndModel(_ context.Context, manufacturer string, model string, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(profile dtos.DeviceProfile) bool {
+		return profile.Manufacturer == manufacturer && profile.Model == model
+	})
+}
+
+func (c *DeviceProfileClient) DeviceResourceByProfileNameAndResourceName(_ context.Context, profileName string, resourceName string) (responses.DeviceResourceResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	i := c.indexOf(profileName)
+	if i < 0 {
+		return responses.DeviceResourceResponse{}, notFound(deviceProfileEntity, "name", profileName)
+	}
+	for _, resource := range c.profiles[i].DeviceResources {
+		if resource.Name == resourceName {
+			return responses.NewDeviceResourceResponse("", "", http.StatusOK, deepCopy(resource).(dtos.DeviceResource)), nil
+		}
+	}
+	return responses.DeviceResourceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+		fmt.Sprintf("device resource %s does not exist in the device profile %s", resourceName, profileName), nil)
+}
+
+// add adds the device profile and returns its id, the mutex must be held by the caller
+func (c *DeviceProfileClient) add(profile dtos.DeviceProfile) (string, errors.EdgeX) {
+	if c.indexOf(profile.Name) >= 0 {
+		return "", duplicateName(deviceProfileEntity, profile.Name)
+	}
+	profile.Id = newId(profile.Id)
+	profile.Created = makeTimestamp()
+	profile.Modified = profile.Created
+	c.profiles = append(c.profiles, deepCopy(profile).(dtos.DeviceProfile))
+	return profile.Id, nil
+}
+
+// update replaces the device profile of the same name, the mutex must be held by the caller
+func (c *DeviceProfileClient) update(profile dtos.DeviceProfile) errors.EdgeX {
+	i := c.indexOf(profile.Name)
+	if i < 0 {
+		return notFound(deviceProfileEntity, "name", profile.Name)
+	}
+	profile.Id = c.profiles[i].Id
+	profile.Created = c.profiles[i].Created
+	profile.Modified = makeTimestamp()
+	c.profiles[i] = deepCopy(profile).(dtos.DeviceProfile)
+	return nil
+}
+
+func (c *DeviceProfileClient) query(offset int, limit int, match func(dtos.DeviceProfile) bool) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	var matched []dtos.DeviceProfile
+	for _, profile := range c.profiles {
+		if match(profile) {
+			matched = append(matched, profile)
+		}
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return responses.MultiDeviceProfilesResponse{}, err
+	}
+	return responses.NewMultiDeviceProfilesResponse("", "", http.StatusOK, uint32(len(matched)), deepCopy(matched[start:end]).([]dtos.DeviceProfile)), nil
+}
+
+// indexOf returns the position of the named device profile, the mutex must be held by the caller
+func (c *DeviceProfileClient) indexOf(name string) int {
+	return indexOf(len(c.profiles), func(i int) bool { return c.profiles[i].Name == name })
+}
+
+// readProfileFile reads the device profile of a YAML file, which is validated while decoded
+func readProfileFile(yamlFilePath string) (dtos.DeviceProfile, errors.EdgeX) {
+	var profile dtos.DeviceProfile
+	data, err := ioutil.ReadFile(yamlFilePath)
+	if err != nil {
+		return profile, errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("fail to read file from %s", yamlFilePath), err)
+	}
+	if err = yaml.Unmarshal(data, &profile); err != nil {
+		return profile, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the device profile YAML", err)
+	}
+	return profile, nil
+}
@@ -0,0 +1,91 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testProfileYaml = `
+name: "uploaded"
+manufacturer: "IOTech"
+model: "B"
+deviceResources:
+  - name: "temperature"
+    properties:
+      valueType: "Int32"
+      readWrite: "R"
+deviceCommands:
+  - name: "readings"
+    readWrite: "R"
+    resourceOperations:
+      - deviceResource: "temperature"
+`
+
+func TestDeviceProfileClient(t *testing.T) {
+	dir := t.TempDir()
+	profileFile := filepath.Join(dir, "profile.yaml")
+	require.NoError(t, ioutil.WriteFile(profileFile, []byte(testProfileYaml), 0600))
+	client := NewDeviceProfileClient(dtos.DeviceProfile{Name: "existing", Manufacturer: "IOTech", Model: "A"})
+
+	res, err := client.AddByYaml(context.Background(), profileFile)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+	_, err = client.AddByYaml(context.Background(), profileFile)
+	assert.Equal(t, errors.KindDuplicateName, errors.Kind(err))
+	_, err = client.AddByYaml(context.Background(), filepath.Join(dir, "missing.yaml"))
+	assert.Equal(t, errors.KindIOError, errors.Kind(err))
+	_, err = client.UpdateByYaml(context.Background(), profileFile)
+	require.NoError(t, err)
+
+	all, err := client.AllDeviceProfiles(context.Background(), nil, 0, -1)
+	require.NoError(t, err)
+	require.Len(t, all.Profiles, 2)
+	uploaded := all.Profiles[1]
+	require.NotEmpty(t, uploaded.DeviceResources)
+
+	resource, err := client.DeviceResourceByProfileNameAndResourceName(context.Background(), uploaded.Name, uploaded.DeviceResources[0].Name)
+	require.NoError(t, err)
+	assert.Equal(t, uploaded.DeviceResources[0], resource.Resource)
+	_, err = client.DeviceResourceByProfileNameAndResourceName(context.Background(), uploaded.Name, "missing")
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	byModel, err := client.DeviceProfilesByManufacturerAndModel(context.Background(), "IOTech", "A", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, byModel.Profiles, 1)
+	assert.Equal(t, "existing", byModel.Profiles[0].Name)
+
+	updated := uploaded
+	updated.Description = "updated"
+	missing := uploaded
+	missing.Name = "missing"
+	updates, err := client.Update(context.Background(), []requests.DeviceProfileRequest{
+		requests.NewDeviceProfileRequest(updated), requests.NewDeviceProfileRequest(missing),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, updates[0].StatusCode)
+	assert.Equal(t, http.StatusNotFound, updates[1].StatusCode)
+	profile, err := client.DeviceProfileByName(context.Background(), uploaded.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "updated", profile.Profile.Description)
+	assert.Equal(t, uploaded.Id, profile.Profile.Id)
+
+	_, err = client.DeleteByName(context.Background(), uploaded.Name)
+	require.NoError(t, err)
+	_, err = client.DeviceProfileByName(context.Background(), uploaded.Name)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
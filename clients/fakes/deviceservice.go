@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const deviceServiceEntity = "device service"
+
+// DeviceServiceClient is an in-memory interfaces.DeviceServiceClient
+type DeviceServiceClient struct {
+	services []dtos.DeviceService
+	mutex    sync.RWMutex
+}
+
+// NewDeviceServiceClient creates an in-memory DeviceServiceClient holding the specified device services
+func NewDeviceServiceClient(services ...dtos.DeviceService) *DeviceServiceClient {
+	return &DeviceServiceClient{services: deepCopy(services).([]dtos.DeviceService)}
+}
+
+func (c *DeviceServiceClient) Add(_ context.Context, reqs []requests.AddDeviceServiceRequest) ([]common.BaseWithIdResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		service := req.Service
+		if c.indexOf(service.Name) >= 0 {
+			res[i] = addResponse(req.RequestId, "", duplicateName(deviceServiceEntity, service.Name))
+			continue
+		}
+		service.Id = newId(service.Id)
+		service.Created = makeTimestamp()
+		service.Modified = service.Created
+		c.services = append(c.services, deepCopy(service).(dtos.DeviceService))
+		res[i] = addResponse(req.RequestId, service.Id, nil)
+	}
+	return res, nil
+}
+
+func (c *DeviceServiceClient) Update(_ context.Context, reqs []requests.UpdateDeviceServiceRequest) ([]common.BaseResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		index, err := findForUpdate(deviceServiceEntity, len(c.services),
+			func(i int) string { return c.services[i].Id }, func(i int) string { return c.services[i].Name },
+			req.Service.Id, req.Service.Name)
+		if err == nil {
+			model := dtos.ToDeviceServiceModel(c.services[index])
+			requests.ReplaceDeviceServiceModelFieldsWithDTO(&model, req.Service)
+			service := dtos.FromDeviceServiceModelToDTO(model)
+			service.Modified = makeTimestamp()
+			c.services[index] = deepCopy(service).(dtos.DeviceService)
+		}
+		res[i] = updateResponse(req.RequestId, err)
+	}
+	return res, nil
+}
+
+func (c *DeviceServiceClient) DeviceServiceByName(_ context.Context, name string) (responses.DeviceServiceResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return responses.DeviceServiceResponse{}, notFound(deviceServiceEntity, "name", name)
+	}
+	return responses.NewDeviceServiceResponse("", "", http.StatusOK, deepCopy(c.services[i]).(dtos.DeviceService)), nil
+}
+
+func (c *DeviceServiceClient) DeleteByName(_ context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return common.BaseResponse{}, notFound(deviceServiceEntity, "name", name)
+	}
+	c.services = append(c.services[:i:i], c.services[i+1:]...)
+	return okResponse(), nil
+}
+
+func (c *DeviceServiceClient) AllDeviceServices(_ context.Context, labels []string, offset int, limit int) (responses.MultiDeviceServicesResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(service dtos.DeviceService) bool { return hasAnyLabel(service.Labels, labels) })
+}
+
+func (c *DeviceServiceClient) query(offset int, limit int, match func(dtos.DeviceService) bool) (responses.MultiDeviceServicesResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	var matched []dtos.DeviceService
+	for _, service := range c.services {
+		if match(service) {
+			matched = append(matched, service)
+		}
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return responses.MultiDeviceServicesResponse{}, err
+	}
+	return responses.NewMultiDeviceServicesResponse("", "", http.StatusOK, uint32(len(matched)), deepCopy(matched[start:end]).([]dtos.DeviceService)), nil
+}
+
+// indexOf returns the position of the named device service, the mutex must be held by the caller
+func (c *DeviceServiceClient) indexOf(name string) int {
+	return indexOf(len(c.services), func(i int) bool { return c.services[i].Name == name })
+}
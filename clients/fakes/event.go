@@ -0,0 +1,157 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// storedEvent is an event along with the time it was added at, in milliseconds
+type storedEvent struct {
+	event   dtos.Event
+	created int64
+}
+
+// EventClient is an in-memory interfaces.EventClient. The events are sorted in descending order of origin, and their
+// readings can be queried with a ReadingClient sharing the EventClient.
+type EventClient struct {
+	events []storedEvent
+	mutex  sync.RWMutex
+}
+
+// NewEventClient creates an in-memory EventClient holding the specified events
+func NewEventClient(events ...dtos.Event) *EventClient {
+	c := &EventClient{}
+	for _, event := range events {
+		c.add(event)
+	}
+	return c
+}
+
+func (c *EventClient) Add(_ context.Context, req requests.AddEventRequest) (common.BaseWithIdResponse, errors.EdgeX) {
+	if err := req.Validate(); err != nil {
+		return common.BaseWithIdResponse{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid request", err)
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return addResponse(req.RequestId, c.add(req.Event), nil), nil
+}
+
+func (c *EventClient) AllEvents(_ context.Context, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(dtos.Event) bool { return true })
+}
+
+func (c *EventClient) EventCount(_ context.Context) (common.CountResponse, errors.EdgeX) {
+	return c.count(func(dtos.Event) bool { return true }), nil
+}
+
+func (c *EventClient) EventCountByDeviceName(_ context.Context, name string) (common.CountResponse, errors.EdgeX) {
+	return c.count(func(event dtos.Event) bool { return event.DeviceName == name }), nil
+}
+
+func (c *EventClient) EventsByDeviceName(_ context.Context, name string, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(event dtos.Event) bool { return event.DeviceName == name })
+}
+
+func (c *EventClient) DeleteByDeviceName(_ context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	c.delete(func(stored storedEvent) bool { return stored.event.DeviceName == name })
+	return okResponse(), nil
+}
+
+func (c *EventClient) EventsByTimeRange(_ context.Context, start, end, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(event dtos.Event) bool {
+		return event.Origin >= int64(start) && event.Origin <= int64(end)
+	})
+}
+
+// DeleteByAge deletes the events added more than age milliseconds ago
+func (c *EventClient) DeleteByAge(_ context.Context, age int) (common.BaseResponse, errors.EdgeX) {
+	expired := makeTimestamp() - int64(age)
+	c.delete(func(stored storedEvent) bool { return stored.created < expired })
+	return okResponse(), nil
+}
+
+// add adds the event, keeping the events sorted in descending order of origin, and returns its id. The mutex must be
+// held by the caller.
+func (c *EventClient) add(event dtos.Event) string {
+	event = deepCopy(event).(dtos.Event)
+	event.Id = newId(event.Id)
+	for i := range event.Readings {
+		event.Readings[i].Id = newId(event.Readings[i].Id)
+	}
+
+	i := sort.Search(len(c.events), func(i int) bool { return c.events[i].event.Origin < event.Origin })
+	c.events = append(c.events, storedEvent{})
+	copy(c.events[i+1:], c.events[i:])
+	c.events[i] = storedEvent{event: event, created: makeTimestamp()}
+	return event.Id
+}
+
+func (c *EventClient) delete(match func(storedEvent) bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	kept := c.events[:0]
+	for _, stored := range c.events {
+		if !match(stored) {
+			kept = append(kept, stored)
+		}
+	}
+	c.events = kept
+}
+
+func (c *EventClient) count(match func(dtos.Event) bool) common.CountResponse {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	var count uint32
+	for _, stored := range c.events {
+		if match(stored.event) {
+			count++
+		}
+	}
+	return common.NewCountResponse("", "", http.StatusOK, count)
+}
+
+func (c *EventClient) query(offset int, limit int, match func(dtos.Event) bool) (responses.MultiEventsResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	var matched []dtos.Event
+	for _, stored := range c.events {
+		if match(stored.event) {
+			matched = append(matched, stored.event)
+		}
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return responses.MultiEventsResponse{}, err
+	}
+	return responses.NewMultiEventsResponse("", "", http.StatusOK, uint32(len(matched)), deepCopy(matched[start:end]).([]dtos.Event)), nil
+}
+
+// readings returns the readings of the events matching, sorted in descending order of origin. The readings are those
+// held by the events, which the caller must copy before returning them.
+func (c *EventClient) readings(match func(dtos.BaseReading) bool) []dtos.BaseReading {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	var matched []dtos.BaseReading
+	for _, stored := range c.events {
+		for _, reading := range stored.event.Readings {
+			if match(reading) {
+				matched = append(matched, reading)
+			}
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].Origin > matched[j].Origin })
+	return matched
+}
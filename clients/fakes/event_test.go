@@ -0,0 +1,79 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvent(t *testing.T, deviceName string, origin int64, resourceNames ...string) dtos.Event {
+	event := dtos.NewEvent("testProfile", deviceName, "testSource")
+	event.Origin = origin
+	for _, resourceName := range resourceNames {
+		require.NoError(t, event.AddSimpleReading(resourceName, common.ValueTypeInt32, int32(1)))
+		event.Readings[len(event.Readings)-1].Origin = origin
+	}
+	return event
+}
+
+func TestEventAndReadingClients(t *testing.T) {
+	events := NewEventClient(testEvent(t, "device1", 100, "temperature", "humidity"))
+	readings := NewReadingClient(events)
+
+	res, err := events.Add(context.Background(), requests.NewAddEventRequest(testEvent(t, "device2", 300, "temperature")))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+	_, err = events.Add(context.Background(), requests.NewAddEventRequest(testEvent(t, "device1", 200, "temperature")))
+	require.NoError(t, err)
+	_, err = events.Add(context.Background(), requests.NewAddEventRequest(dtos.Event{}))
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	all, err := events.AllEvents(context.Background(), 0, -1)
+	require.NoError(t, err)
+	require.Len(t, all.Events, 3)
+	assert.Equal(t, []int64{300, 200, 100}, []int64{all.Events[0].Origin, all.Events[1].Origin, all.Events[2].Origin})
+	assert.Equal(t, res.Id, all.Events[0].Id)
+
+	count, err := events.EventCountByDeviceName(context.Background(), "device1")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), count.Count)
+	byTime, err := events.EventsByTimeRange(context.Background(), 150, 300, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), byTime.TotalCount)
+
+	readingCount, err := readings.ReadingCount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint32(4), readingCount.Count)
+	temperatures, err := readings.ReadingsByDeviceNameAndResourceNamesAndTimeRange(context.Background(), "device1", []string{"temperature"}, 0, 1000, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, temperatures.Readings, 2)
+	assert.Equal(t, int64(200), temperatures.Readings[0].Origin)
+	assert.NotEmpty(t, temperatures.Readings[0].Id)
+
+	_, err = events.DeleteByDeviceName(context.Background(), "device1")
+	require.NoError(t, err)
+	readingCount, err = readings.ReadingCount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), readingCount.Count)
+
+	time.Sleep(2 * time.Millisecond)
+	_, err = events.DeleteByAge(context.Background(), 0)
+	require.NoError(t, err)
+	count, err = events.EventCount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), count.Count)
+}
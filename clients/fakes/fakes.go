@@ -0,0 +1,208 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fakes provides working in-memory implementations of the client interfaces, for the tests exercising the
+// code built on top of the clients without the EdgeX services nor per-call expectations. Like the services, the fakes
+// validate the requests, store the entities, enforce the uniqueness of their names, honor the offset, limit and labels
+// of the queries, and fail with the errors.EdgeX kinds the services respond with. The fakes are safe for concurrent
+// use.
+package fakes
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/google/uuid"
+)
+
+// validator is implemented by the request DTOs
+type validator interface {
+	Validate() error
+}
+
+// validateRequests validates each request, the whole batch being rejected if any request is invalid
+func validateRequests(count int, request func(i int) validator) errors.EdgeX {
+	for i := 0; i < count; i++ {
+		if err := request(i).Validate(); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid request", err)
+		}
+	}
+	return nil
+}
+
+// page returns the range of the items selected by offset and limit among total items. A negative limit selects all
+// the remaining items after offset.
+func page(total int, offset int, limit int) (int, int, errors.EdgeX) {
+	if offset < 0 {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid offset %d", offset), nil)
+	}
+	if offset > total {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, fmt.Sprintf("query objects bounds out of range. length:%d offset:%d", total, offset), nil)
+	}
+	end := total
+	if limit >= 0 && offset+limit < total {
+		end = offset + limit
+	}
+	return offset, end, nil
+}
+
+// hasAnyLabel checks whether the entity labels contain any of the queried labels, any entity matching when no label
+// is queried
+func hasAnyLabel(entityLabels []string, labels []string) bool {
+	if len(labels) == 0 {
+		return true
+	}
+	for _, label := range labels {
+		if contains(entityLabels, label) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// indexOf returns the position of the first of count items matching, -1 if none matches
+func indexOf(count int, match func(i int) bool) int {
+	for i := 0; i < count; i++ {
+		if match(i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// findForUpdate returns the position of the entity identified by the id of an update or, without id, by its name
+func findForUpdate(entity string, count int, idAt func(i int) string, nameAt func(i int) string, id *string, name *string) (int, errors.EdgeX) {
+	var i int
+	switch {
+	case id != nil && *id != "":
+		if i = indexOf(count, func(i int) bool { return idAt(i) == *id }); i < 0 {
+			return -1, notFound(entity, "id", *id)
+		}
+		if name != nil && *name != nameAt(i) {
+			return -1, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s name %s does not match the existing %s", entity, *name, nameAt(i)), nil)
+		}
+	case name != nil:
+		if i = indexOf(count, func(i int) bool { return nameAt(i) == *name }); i < 0 {
+			return -1, notFound(entity, "name", *name)
+		}
+	default:
+		return -1, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no id nor name to identify the %s", entity), nil)
+	}
+	return i, nil
+}
+
+func notFound(entity string, field string, value string) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("%s %s %s does not exist", entity, field, value), nil)
+}
+
+func duplicateName(entity string, name string) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("%s name %s exists", entity, name), nil)
+}
+
+// addResponse returns the response of an added item, a failed one if err is not nil
+func addResponse(requestId string, id string, err errors.EdgeX) dtoCommon.BaseWithIdResponse {
+	if err != nil {
+		return dtoCommon.NewBaseWithIdResponse(requestId, err.Error(), err.Code(), "")
+	}
+	return dtoCommon.NewBaseWithIdResponse(requestId, "", http.StatusCreated, id)
+}
+
+// updateResponse returns the response of an updated item, a failed one if err is not nil
+func updateResponse(requestId string, err errors.EdgeX) dtoCommon.BaseResponse {
+	if err != nil {
+		return dtoCommon.NewBaseResponse(requestId, err.Error(), err.Code())
+	}
+	return dtoCommon.NewBaseResponse(requestId, "", http.StatusOK)
+}
+
+func okResponse() dtoCommon.BaseResponse {
+	return dtoCommon.NewBaseResponse("", "", http.StatusOK)
+}
+
+// deepCopy returns a copy of v sharing none of its slices, maps and pointers. The fakes store and return copies of the
+// entities, so that a caller modifying the DTOs it passed or got back doesn't alter the entities held by the fakes.
+func deepCopy(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	src := reflect.ValueOf(v)
+	dst := reflect.New(src.Type()).Elem()
+	copyValue(dst, src)
+	return dst.Interface()
+}
+
+// copyValue deep copies src into dst, a settable value of the same type
+func copyValue(dst reflect.Value, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Ptr:
+		if !src.IsNil() {
+			dst.Set(reflect.New(src.Elem().Type()))
+			copyValue(dst.Elem(), src.Elem())
+		}
+	case reflect.Interface:
+		if !src.IsNil() {
+			elem := reflect.New(src.Elem().Type()).Elem()
+			copyValue(elem, src.Elem())
+			dst.Set(elem)
+		}
+	case reflect.Slice:
+		if !src.IsNil() {
+			dst.Set(reflect.MakeSlice(src.Type(), src.Len(), src.Len()))
+			for i := 0; i < src.Len(); i++ {
+				copyValue(dst.Index(i), src.Index(i))
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			copyValue(dst.Index(i), src.Index(i))
+		}
+	case reflect.Map:
+		if !src.IsNil() {
+			dst.Set(reflect.MakeMapWithSize(src.Type(), src.Len()))
+			for iter := src.MapRange(); iter.Next(); {
+				value := reflect.New(iter.Value().Type()).Elem()
+				copyValue(value, iter.Value())
+				dst.SetMapIndex(iter.Key(), value)
+			}
+		}
+	case reflect.Struct:
+		// the unexported fields, which can't be set, are shallow copied along with the struct
+		dst.Set(src)
+		for i := 0; i < src.NumField(); i++ {
+			if dst.Field(i).CanSet() {
+				copyValue(dst.Field(i), src.Field(i))
+			}
+		}
+	default:
+		dst.Set(src)
+	}
+}
+
+// newId returns the id of a new entity, the specified one if any
+func newId(id string) string {
+	if id != "" {
+		return id
+	}
+	return uuid.NewString()
+}
+
+// makeTimestamp returns the current time in milliseconds, as the created and modified timestamps of the entities
+func makeTimestamp() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
@@ -0,0 +1,97 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakesImplementInterfaces(t *testing.T) {
+	events := NewEventClient()
+	subscriptions := NewSubscriptionClient()
+	transmissions := NewTransmissionClient()
+	clients := []interface{}{
+		interfaces.DeviceClient(NewDeviceClient()),
+		interfaces.DeviceProfileClient(NewDeviceProfileClient()),
+		interfaces.DeviceServiceClient(NewDeviceServiceClient()),
+		interfaces.ProvisionWatcherClient(NewProvisionWatcherClient()),
+		interfaces.EventClient(events),
+		interfaces.ReadingClient(NewReadingClient(events)),
+		interfaces.NotificationClient(NewNotificationClient(subscriptions, transmissions)),
+		interfaces.SubscriptionClient(subscriptions),
+		interfaces.TransmissionClient(transmissions),
+		interfaces.IntervalClient(NewIntervalClient()),
+		interfaces.IntervalActionClient(NewIntervalActionClient()),
+	}
+	assert.Len(t, clients, 11)
+}
+
+func TestDeepCopy(t *testing.T) {
+	type entity struct {
+		Reading  dtos.BaseReading
+		Pointer  *int
+		Readings map[string][]dtos.BaseReading
+	}
+	newEntity := func() entity {
+		value := 1
+		reading := dtos.BaseReading{
+			Id:            "id",
+			BinaryReading: dtos.BinaryReading{BinaryValue: []byte{1, 2}},
+			ObjectReading: dtos.ObjectReading{ObjectValue: map[string]interface{}{"values": []interface{}{1, "two"}}},
+		}
+		return entity{reading, &value, map[string][]dtos.BaseReading{"readings": {reading}}}
+	}
+	original := newEntity()
+
+	copied := deepCopy(original)
+	require.Equal(t, original, copied)
+
+	original.Reading.BinaryValue[0] = 0
+	original.Reading.ObjectValue.(map[string]interface{})["values"].([]interface{})[0] = 0
+	*original.Pointer = 0
+	original.Readings["readings"][0].Id = "changed"
+	original.Readings["other"] = nil
+	assert.Equal(t, newEntity(), copied)
+	assert.Nil(t, deepCopy([]dtos.Device(nil)))
+}
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		name          string
+		offset        int
+		limit         int
+		expectedStart int
+		expectedEnd   int
+		expectedKind  errors.ErrKind
+	}{
+		{"first page", 0, 2, 0, 2, ""},
+		{"last page", 4, 2, 4, 5, ""},
+		{"all remaining", 1, -1, 1, 5, ""},
+		{"offset at the end", 5, 2, 5, 5, ""},
+		{"offset out of range", 6, 2, 0, 0, errors.KindRangeNotSatisfiable},
+		{"negative offset", -1, 2, 0, 0, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			start, end, err := page(5, testCase.offset, testCase.limit)
+			if testCase.expectedKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStart, start)
+			assert.Equal(t, testCase.expectedEnd, end)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const intervalEntity = "interval"
+
+// IntervalClient is an in-memory interfaces.IntervalClient
+type IntervalClient struct {
+	intervals []dtos.Interval
+	mutex     sync.RWMutex
+}
+
+// NewIntervalClient creates an in-memory IntervalClient holding the specified intervals
+func NewIntervalClient(intervals ...dtos.Interval) *IntervalClient {
+	return &IntervalClient{intervals: deepCopy(intervals).([]dtos.Interval)}
+}
+
+func (c *IntervalClient) Add(_ context.Context, reqs []requests.AddIntervalRequest) ([]common.BaseWithIdResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		interval := req.Interval
+		if c.indexOf(interval.Name) >= 0 {
+			res[i] = addResponse(req.RequestId, "", duplicateName(intervalEntity, interval.Name))
+			continue
+		}
+		interval.Id = newId(interval.Id)
+		interval.Created = makeTimestamp()
+		interval.Modified = interval.Created
+		c.intervals = append(c.intervals, deepCopy(interval).(dtos.Interval))
+		res[i] = addResponse(req.RequestId, interval.Id, nil)
+	}
+	return res, nil
+}
+
+func (c *IntervalClient) Update(_ context.Context, reqs []requests.UpdateIntervalRequest) ([]common.BaseResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		index, err := findForUpdate(intervalEntity, len(c.intervals),
+			func(i int) string { return c.intervals[i].Id }, func(i int) string { return c.intervals[i].Name },
+			req.Interval.Id, req.Interval.Name)
+		if err == nil {
+			model := dtos.ToIntervalModel(c.intervals[index])
+			requests.ReplaceIntervalModelFieldsWithDTO(&model, req.Interval)
+			interval := dtos.FromIntervalModelToDTO(model)
+			interval.Modified = makeTimestamp()
+			c.intervals[index] = deepCopy(interval).(dtos.Interval)
+		}
+		res[i] = updateResponse(req.RequestId, err)
+	}
+	return res, nil
+}
+
+func (c *IntervalClient) IntervalByName(_ context.Context, name string) (responses.IntervalResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return responses.IntervalResponse{}, notFound(intervalEntity, "name", name)
+	}
+	return responses.NewIntervalResponse("", "", http.StatusOK, deepCopy(c.intervals[i]).(dtos.Interval)), nil
+}
+
+func (c *IntervalClient) DeleteIntervalByName(_ context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return common.BaseResponse{}, notFound(intervalEntity, "name", name)
+	}
+	c.intervals = append(c.intervals[:i:i], c.intervals[i+1:]...)
+	return okResponse(), nil
+}
+
+func (c *IntervalClient) AllIntervals(_ context.Context, offset int, limit int) (responses.MultiIntervalsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(dtos.Interval) bool { return true })
+}
+
+func (c *IntervalClient) query(offset int, limit int, match func(dtos.Interval) bool) (responses.MultiIntervalsResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	var matched []dtos.Interval
+	for _, interval := range c.intervals {
+		if match(interval) {
+			matched = append(matched, interval)
+		}
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return responses.MultiIntervalsResponse{}, err
+	}
+	return responses.NewMultiIntervalsResponse("", "", http.StatusOK, uint32(len(matched)), deepCopy(matched[start:end]).([]dtos.Interval)), nil
+}
+
+// indexOf returns the position of the named interval, the mutex must be held by the caller
+func (c *IntervalClient) indexOf(name string) int {
+	return indexOf(len(c.intervals), func(i int) bool { return c.intervals[i].Name == name })
+}
@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const intervalActionEntity = "interval action"
+
+// IntervalActionClient is an in-memory interfaces.IntervalActionClient
+type IntervalActionClient struct {
+	actions []dtos.IntervalAction
+	mutex   sync.RWMutex
+}
+
+// NewIntervalActionClient creates an in-memory IntervalActionClient holding the specified interval actions
+func NewIntervalActionClient(actions ...dtos.IntervalAction) *IntervalActionClient {
+	return &IntervalActionClient{actions: deepCopy(actions).([]dtos.IntervalAction)}
+}
+
+func (c *IntervalActionClient) Add(_ context.Context, reqs []requests.AddIntervalActionRequest) ([]common.BaseWithIdResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		action := req.Action
+		if c.indexOf(action.Name) >= 0 {
+			res[i] = addResponse(req.RequestId, "", duplicateName(intervalActionEntity, action.Name))
+			continue
+		}
+		action.Id = newId(action.Id)
+		action.Created = makeTimestamp()
+		action.Modified = action.Created
+		c.actions = append(c.actions, deepCopy(action).(dtos.IntervalAction))
+		res[i] = addResponse(req.RequestId, action.Id, nil)
+	}
+	return res, nil
+}
+
+func (c *IntervalActionClient) Update(_ context.Context, reqs []requests.UpdateIntervalActionRequest) ([]common.BaseResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		index, err := findForUpdate(intervalActionEntity, len(c.actions),
+			func(i int) string { return c.actions[i].Id }, func(i int) string { return c.actions[i].Name },
+			req.Action.Id, req.Action.Name)
+		if err == nil {
+			model := dtos.ToIntervalActionModel(c.actions[index])
+			requests.ReplaceIntervalActionModelFieldsWithDTO(&model, req.Action)
+			action := dtos.FromIntervalActionModelToDTO(model)
+			action.Modified = makeTimestamp()
+			c.actions[index] = deepCopy(action).(dtos.IntervalAction)
+		}
+		res[i] = updateResponse(req.RequestId, err)
+	}
+	return res, nil
+}
+
+func (c *IntervalActionClient) IntervalActionByName(_ context.Context, name string) (responses.IntervalActionResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return responses.IntervalActionResponse{}, notFound(intervalActionEntity, "name", name)
+	}
+	return responses.NewIntervalActionResponse("", "", http.StatusOK, deepCopy(c.actions[i]).(dtos.IntervalAction)), nil
+}
+
+func (c *IntervalActionClient) DeleteIntervalActionByName(_ context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return common.BaseResponse{}, notFound(intervalActionEntity, "name", name)
+	}
+	c.actions = append(c.actions[:i:i], c.actions[i+1:]...)
+	return okResponse(), nil
+}
+
+func (c *IntervalActionClient) AllIntervalActions(_ context.Context, offset int, limit int) (responses.MultiIntervalActionsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(dtos.IntervalAction) bool { return true })
+}
+
+func (c *IntervalActionClient) query(offset int, limit int, match func(dtos.IntervalAction) bool) (responses.MultiIntervalActionsResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	var matched []dtos.IntervalAction
+	for _, action := range c.actions {
+		if match(action) {
+			matched = append(matched, action)
+		}
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return responses.MultiIntervalActionsResponse{}, err
+	}
+	return responses.NewMultiIntervalActionsResponse("", "", http.StatusOK, uint32(len(matched)), deepCopy(matched[start:end]).([]dtos.IntervalAction)), nil
+}
+
+// indexOf returns the position of the named interval action, the mutex must be held by the caller
+func (c *IntervalActionClient) indexOf(name string) int {
+	return indexOf(len(c.actions), func(i int) bool { return c.actions[i].Name == name })
+}
@@ -0,0 +1,181 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+const notificationEntity = "notification"
+
+// NotificationClient is an in-memory interfaces.NotificationClient. The notifications of a subscription are the ones
+// whose category or labels match the subscription of the SubscriptionClient, and the transmissions of the
+// TransmissionClient are cleaned up along with their notifications. Both clients are optional.
+type NotificationClient struct {
+	notifications []dtos.Notification
+	subscriptions *SubscriptionClient
+	transmissions *TransmissionClient
+	mutex         sync.RWMutex
+}
+
+// NewNotificationClient creates an in-memory NotificationClient holding the specified notifications
+func NewNotificationClient(subscriptions *SubscriptionClient, transmissions *TransmissionClient, notifications ...dtos.Notification) *NotificationClient {
+	return &NotificationClient{
+		notifications: deepCopy(notifications).([]dtos.Notification),
+		subscriptions: subscriptions,
+		transmissions: transmissions,
+	}
+}
+
+func (c *NotificationClient) SendNotification(_ context.Context, reqs []requests.AddNotificationRequest) ([]common.BaseWithIdResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		notification := req.Notification
+		notification.Id = newId(notification.Id)
+		if notification.Status == "" {
+			notification.Status = models.New
+		}
+		notification.Created = makeTimestamp()
+		notification.Modified = notification.Created
+		c.notifications = append(c.notifications, deepCopy(notification).(dtos.Notification))
+		res[i] = addResponse(req.RequestId, notification.Id, nil)
+	}
+	return res, nil
+}
+
+func (c *NotificationClient) NotificationById(_ context.Context, id string) (responses.NotificationResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	i := indexOf(len(c.notifications), func(i int) bool { return c.notifications[i].Id == id })
+	if i < 0 {
+		return responses.NotificationResponse{}, notFound(notificationEntity, "id", id)
+	}
+	return responses.NewNotificationResponse("", "", http.StatusOK, deepCopy(c.notifications[i]).(dtos.Notification)), nil
+}
+
+func (c *NotificationClient) DeleteNotificationById(_ context.Context, id string) (common.BaseResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	i := indexOf(len(c.notifications), func(i int) bool { return c.notifications[i].Id == id })
+	if i < 0 {
+		c.mutex.Unlock()
+		return common.BaseResponse{}, notFound(notificationEntity, "id", id)
+	}
+	c.notifications = append(c.notifications[:i:i], c.notifications[i+1:]...)
+	c.mutex.Unlock()
+	c.deleteTransmissions([]string{id})
+	return okResponse(), nil
+}
+
+func (c *NotificationClient) NotificationsByCategory(_ context.Context, category string, offset int, limit int) (responses.MultiNotificationsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(notification dtos.Notification) bool { return notification.Category == category })
+}
+
+func (c *NotificationClient) NotificationsByLabel(_ context.Context, label string, offset int, limit int) (responses.MultiNotificationsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(notification dtos.Notification) bool { return contains(notification.Labels, label) })
+}
+
+func (c *NotificationClient) NotificationsByStatus(_ context.Context, status string, offset int, limit int) (responses.MultiNotificationsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(notification dtos.Notification) bool { return notification.Status == status })
+}
+
+func (c *NotificationClient) NotificationsByTimeRange(_ context.Context, start int, end int, offset int, limit int) (responses.MultiNotificationsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(notification dtos.Notification) bool {
+		return notification.Created >= int64(start) && notification.Created <= int64(end)
+	})
+}
+
+func (c *NotificationClient) NotificationsBySubscriptionName(ctx context.Context, subscriptionName string, offset int, limit int) (responses.MultiNotificationsResponse, errors.EdgeX) {
+	if c.subscriptions == nil {
+		return responses.MultiNotificationsResponse{}, notFound(subscriptionEntity, "name", subscriptionName)
+	}
+	res, err := c.subscriptions.SubscriptionByName(ctx, subscriptionName)
+	if err != nil {
+		return responses.MultiNotificationsResponse{}, err
+	}
+	subscription := res.Subscription
+	return c.query(offset, limit, func(notification dtos.Notification) bool {
+		return contains(subscription.Categories, notification.Category) ||
+			(len(notification.Labels) > 0 && hasAnyLabel(subscription.Labels, notification.Labels))
+	})
+}
+
+// CleanupNotificationsByAge deletes the notifications created more than age milliseconds ago and their transmissions
+func (c *NotificationClient) CleanupNotificationsByAge(_ context.Context, age int) (common.BaseResponse, errors.EdgeX) {
+	expired := makeTimestamp() - int64(age)
+	c.delete(func(notification dtos.Notification) bool { return notification.Created < expired })
+	return okResponse(), nil
+}
+
+// CleanupNotifications deletes all the notifications and their transmissions
+func (c *NotificationClient) CleanupNotifications(_ context.Context) (common.BaseResponse, errors.EdgeX) {
+	c.delete(func(dtos.Notification) bool { return true })
+	return okResponse(), nil
+}
+
+// DeleteProcessedNotificationsByAge deletes the processed notifications created more than age milliseconds ago and
+// their transmissions
+func (c *NotificationClient) DeleteProcessedNotificationsByAge(_ context.Context, age int) (common.BaseResponse, errors.EdgeX) {
+	expired := makeTimestamp() - int64(age)
+	c.delete(func(notification dtos.Notification) bool {
+		return notification.Status == models.Processed && notification.Created < expired
+	})
+	return okResponse(), nil
+}
+
+func (c *NotificationClient) delete(match func(dtos.Notification) bool) {
+	c.mutex.Lock()
+	var deleted []string
+	kept := c.notifications[:0]
+	for _, notification := range c.notifications {
+		if match(notification) {
+			deleted = append(deleted, notification.Id)
+			continue
+		}
+		kept = append(kept, notification)
+	}
+	c.notifications = kept
+	c.mutex.Unlock()
+	c.deleteTransmissions(deleted)
+}
+
+func (c *NotificationClient) deleteTransmissions(notificationIds []string) {
+	if c.transmissions == nil || len(notificationIds) == 0 {
+		return
+	}
+	c.transmissions.delete(func(transmission dtos.Transmission) bool {
+		return contains(notificationIds, transmission.NotificationId)
+	})
+}
+
+func (c *NotificationClient) query(offset int, limit int, match func(dtos.Notification) bool) (responses.MultiNotificationsResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	var matched []dtos.Notification
+	for _, notification := range c.notifications {
+		if match(notification) {
+			matched = append(matched, notification)
+		}
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return responses.MultiNotificationsResponse{}, err
+	}
+	return responses.NewMultiNotificationsResponse("", "", http.StatusOK, uint32(len(matched)), deepCopy(matched[start:end]).([]dtos.Notification)), nil
+}
@@ -0,0 +1,67 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationClient(t *testing.T) {
+	subscriptions := NewSubscriptionClient(dtos.Subscription{
+		Name:       "alerts",
+		Receiver:   "operator",
+		Categories: []string{"health"},
+		Labels:     []string{"critical"},
+		Channels:   []dtos.Address{dtos.NewEmailAddress([]string{"ops@example.com"})},
+		AdminState: models.Unlocked,
+	})
+	transmissions := NewTransmissionClient()
+	client := NewNotificationClient(subscriptions, transmissions)
+
+	res, err := client.SendNotification(context.Background(), []requests.AddNotificationRequest{
+		requests.NewAddNotificationRequest(dtos.NewNotification(nil, "health", "disk full", "core-data", models.Critical)),
+		requests.NewAddNotificationRequest(dtos.NewNotification([]string{"critical"}, "other", "device down", "core-metadata", models.Critical)),
+		requests.NewAddNotificationRequest(dtos.NewNotification([]string{"info"}, "other", "device up", "core-metadata", models.Normal)),
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	assert.Equal(t, http.StatusCreated, res[0].StatusCode)
+	_, err = client.SendNotification(context.Background(), []requests.AddNotificationRequest{
+		requests.NewAddNotificationRequest(dtos.Notification{}),
+	})
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	notification, err := client.NotificationById(context.Background(), res[0].Id)
+	require.NoError(t, err)
+	assert.Equal(t, models.New, notification.Notification.Status)
+
+	subscribed, err := client.NotificationsBySubscriptionName(context.Background(), "alerts", 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), subscribed.TotalCount)
+	_, err = client.NotificationsBySubscriptionName(context.Background(), "missing", 0, -1)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	transmissions.transmissions = append(transmissions.transmissions, dtos.Transmission{
+		Id: newId(""), NotificationId: res[0].Id, SubscriptionName: "alerts", Status: models.Sent,
+	})
+	_, err = client.CleanupNotifications(context.Background())
+	require.NoError(t, err)
+	all, err := transmissions.AllTransmissions(context.Background(), 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), all.TotalCount)
+	_, err = client.NotificationById(context.Background(), res[0].Id)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
@@ -0,0 +1,130 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const provisionWatcherEntity = "provision watcher"
+
+// ProvisionWatcherClient is an in-memory interfaces.ProvisionWatcherClient
+type ProvisionWatcherClient struct {
+	watchers []dtos.ProvisionWatcher
+	mutex    sync.RWMutex
+}
+
+// NewProvisionWatcherClient creates an in-memory ProvisionWatcherClient holding the specified provision watchers
+func NewProvisionWatcherClient(watchers ...dtos.ProvisionWatcher) *ProvisionWatcherClient {
+	return &ProvisionWatcherClient{watchers: deepCopy(watchers).([]dtos.ProvisionWatcher)}
+}
+
+func (c *ProvisionWatcherClient) Add(_ context.Context, reqs []requests.AddProvisionWatcherRequest) ([]common.BaseWithIdResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		watcher := req.ProvisionWatcher
+		if c.indexOf(watcher.Name) >= 0 {
+			res[i] = addResponse(req.RequestId, "", duplicateName(provisionWatcherEntity, watcher.Name))
+			continue
+		}
+		watcher.Id = newId(watcher.Id)
+		watcher.Created = makeTimestamp()
+		watcher.Modified = watcher.Created
+		c.watchers = append(c.watchers, deepCopy(watcher).(dtos.ProvisionWatcher))
+		res[i] = addResponse(req.RequestId, watcher.Id, nil)
+	}
+	return res, nil
+}
+
+func (c *ProvisionWatcherClient) Update(_ context.Context, reqs []requests.UpdateProvisionWatcherRequest) ([]common.BaseResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		index, err := findForUpdate(provisionWatcherEntity, len(c.watchers),
+			func(i int) string { return c.watchers[i].Id }, func(i int) string { return c.watchers[i].Name },
+			req.ProvisionWatcher.Id, req.ProvisionWatcher.Name)
+		if err == nil {
+			model := dtos.ToProvisionWatcherModel(c.watchers[index])
+			requests.ReplaceProvisionWatcherModelFieldsWithDTO(&model, req.ProvisionWatcher)
+			watcher := dtos.FromProvisionWatcherModelToDTO(model)
+			watcher.Modified = makeTimestamp()
+			c.watchers[index] = deepCopy(watcher).(dtos.ProvisionWatcher)
+		}
+		res[i] = updateResponse(req.RequestId, err)
+	}
+	return res, nil
+}
+
+func (c *ProvisionWatcherClient) ProvisionWatcherByName(_ context.Context, name string) (responses.ProvisionWatcherResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return responses.ProvisionWatcherResponse{}, notFound(provisionWatcherEntity, "name", name)
+	}
+	return responses.NewProvisionWatcherResponse("", "", http.StatusOK, deepCopy(c.watchers[i]).(dtos.ProvisionWatcher)), nil
+}
+
+func (c *ProvisionWatcherClient) DeleteProvisionWatcherByName(_ context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return common.BaseResponse{}, notFound(provisionWatcherEntity, "name", name)
+	}
+	c.watchers = append(c.watchers[:i:i], c.watchers[i+1:]...)
+	return okResponse(), nil
+}
+
+func (c *ProvisionWatcherClient) AllProvisionWatchers(_ context.Context, labels []string, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(watcher dtos.ProvisionWatcher) bool { return hasAnyLabel(watcher.Labels, labels) })
+}
+
+func (c *ProvisionWatcherClient) ProvisionWatchersByProfileName(_ context.Context, name string, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(watcher dtos.ProvisionWatcher) bool { return watcher.ProfileName == name })
+}
+
+func (c *ProvisionWatcherClient) ProvisionWatchersByServiceName(_ context.Context, name string, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(watcher dtos.ProvisionWatcher) bool { return watcher.ServiceName == name })
+}
+
+func (c *ProvisionWatcherClient) query(offset int, limit int, match func(dtos.ProvisionWatcher) bool) (responses.MultiProvisionWatchersResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	var matched []dtos.ProvisionWatcher
+	for _, watcher := range c.watchers {
+		if match(watcher) {
+			matched = append(matched, watcher)
+		}
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return responses.MultiProvisionWatchersResponse{}, err
+	}
+	return responses.NewMultiProvisionWatchersResponse("", "", http.StatusOK, uint32(len(matched)), deepCopy(matched[start:end]).([]dtos.ProvisionWatcher)), nil
+}
+
+// indexOf returns the position of the named provision watcher, the mutex must be held by the caller
+func (c *ProvisionWatcherClient) indexOf(name string) int {
+	return indexOf(len(c.watchers), func(i int) bool { return c.watchers[i].Name == name })
+}
@@ -0,0 +1,93 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// ReadingClient is an in-memory interfaces.ReadingClient querying the readings of the events of an EventClient, sorted
+// in descending order of origin
+type ReadingClient struct {
+	events *EventClient
+}
+
+// NewReadingClient creates an in-memory ReadingClient querying the readings of the events of the EventClient
+func NewReadingClient(events *EventClient) *ReadingClient {
+	return &ReadingClient{events: events}
+}
+
+func (c *ReadingClient) AllReadings(_ context.Context, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(dtos.BaseReading) bool { return true })
+}
+
+func (c *ReadingClient) ReadingCount(_ context.Context) (common.CountResponse, errors.EdgeX) {
+	return c.count(func(dtos.BaseReading) bool { return true }), nil
+}
+
+func (c *ReadingClient) ReadingCountByDeviceName(_ context.Context, name string) (common.CountResponse, errors.EdgeX) {
+	return c.count(func(reading dtos.BaseReading) bool { return reading.DeviceName == name }), nil
+}
+
+func (c *ReadingClient) ReadingsByDeviceName(_ context.Context, name string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(reading dtos.BaseReading) bool { return reading.DeviceName == name })
+}
+
+func (c *ReadingClient) ReadingsByResourceName(_ context.Context, name string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(reading dtos.BaseReading) bool { return reading.ResourceName == name })
+}
+
+func (c *ReadingClient) ReadingsByTimeRange(_ context.Context, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(reading dtos.BaseReading) bool { return inTimeRange(reading, start, end) })
+}
+
+func (c *ReadingClient) ReadingsByResourceNameAndTimeRange(_ context.Context, name string, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(reading dtos.BaseReading) bool {
+		return reading.ResourceName == name && inTimeRange(reading, start, end)
+	})
+}
+
+func (c *ReadingClient) ReadingsByDeviceNameAndResourceName(_ context.Context, deviceName, resourceName string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(reading dtos.BaseReading) bool {
+		return reading.DeviceName == deviceName && reading.ResourceName == resourceName
+	})
+}
+
+func (c *ReadingClient) ReadingsByDeviceNameAndResourceNameAndTimeRange(_ context.Context, deviceName, resourceName string, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(reading dtos.BaseReading) bool {
+		return reading.DeviceName == deviceName && reading.ResourceName == resourceName && inTimeRange(reading, start, end)
+	})
+}
+
+func (c *ReadingClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(_ context.Context, deviceName string, resourceNames []string, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(reading dtos.BaseReading) bool {
+		return reading.DeviceName == deviceName && (len(resourceNames) == 0 || contains(resourceNames, reading.ResourceName)) &&
+			inTimeRange(reading, start, end)
+	})
+}
+
+func (c *ReadingClient) count(match func(dtos.BaseReading) bool) common.CountResponse {
+	return common.NewCountResponse("", "", http.StatusOK, uint32(len(c.events.readings(match))))
+}
+
+func (c *ReadingClient) query(offset int, limit int, match func(dtos.BaseReading) bool) (responses.MultiReadingsResponse, errors.EdgeX) {
+	matched := c.events.readings(match)
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return responses.MultiReadingsResponse{}, err
+	}
+	return responses.NewMultiReadingsResponse("", "", http.StatusOK, uint32(len(matched)), deepCopy(matched[start:end]).([]dtos.BaseReading)), nil
+}
+
+func inTimeRange(reading dtos.BaseReading, start int, end int) bool {
+	return reading.Origin >= int64(start) && reading.Origin <= int64(end)
+}
@@ -0,0 +1,134 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const subscriptionEntity = "subscription"
+
+// SubscriptionClient is an in-memory interfaces.SubscriptionClient
+type SubscriptionClient struct {
+	subscriptions []dtos.Subscription
+	mutex         sync.RWMutex
+}
+
+// NewSubscriptionClient creates an in-memory SubscriptionClient holding the specified subscriptions
+func NewSubscriptionClient(subscriptions ...dtos.Subscription) *SubscriptionClient {
+	return &SubscriptionClient{subscriptions: deepCopy(subscriptions).([]dtos.Subscription)}
+}
+
+func (c *SubscriptionClient) Add(_ context.Context, reqs []requests.AddSubscriptionRequest) ([]common.BaseWithIdResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		subscription := req.Subscription
+		if c.indexOf(subscription.Name) >= 0 {
+			res[i] = addResponse(req.RequestId, "", duplicateName(subscriptionEntity, subscription.Name))
+			continue
+		}
+		subscription.Id = newId(subscription.Id)
+		subscription.Created = makeTimestamp()
+		subscription.Modified = subscription.Created
+		c.subscriptions = append(c.subscriptions, deepCopy(subscription).(dtos.Subscription))
+		res[i] = addResponse(req.RequestId, subscription.Id, nil)
+	}
+	return res, nil
+}
+
+func (c *SubscriptionClient) Update(_ context.Context, reqs []requests.UpdateSubscriptionRequest) ([]common.BaseResponse, errors.EdgeX) {
+	if err := validateRequests(len(reqs), func(i int) validator { return reqs[i] }); err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	res := make([]common.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		index, err := findForUpdate(subscriptionEntity, len(c.subscriptions),
+			func(i int) string { return c.subscriptions[i].Id }, func(i int) string { return c.subscriptions[i].Name },
+			req.Subscription.Id, req.Subscription.Name)
+		if err == nil {
+			model := dtos.ToSubscriptionModel(c.subscriptions[index])
+			requests.ReplaceSubscriptionModelFieldsWithDTO(&model, req.Subscription)
+			subscription := dtos.FromSubscriptionModelToDTO(model)
+			subscription.Modified = makeTimestamp()
+			c.subscriptions[index] = deepCopy(subscription).(dtos.Subscription)
+		}
+		res[i] = updateResponse(req.RequestId, err)
+	}
+	return res, nil
+}
+
+func (c *SubscriptionClient) SubscriptionByName(_ context.Context, name string) (responses.SubscriptionResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return responses.SubscriptionResponse{}, notFound(subscriptionEntity, "name", name)
+	}
+	return responses.NewSubscriptionResponse("", "", http.StatusOK, deepCopy(c.subscriptions[i]).(dtos.Subscription)), nil
+}
+
+func (c *SubscriptionClient) DeleteSubscriptionByName(_ context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	i := c.indexOf(name)
+	if i < 0 {
+		return common.BaseResponse{}, notFound(subscriptionEntity, "name", name)
+	}
+	c.subscriptions = append(c.subscriptions[:i:i], c.subscriptions[i+1:]...)
+	return okResponse(), nil
+}
+
+func (c *SubscriptionClient) AllSubscriptions(_ context.Context, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(dtos.Subscription) bool { return true })
+}
+
+func (c *SubscriptionClient) SubscriptionsByCategory(_ context.Context, category string, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(subscription dtos.Subscription) bool { return contains(subscription.Categories, category) })
+}
+
+func (c *SubscriptionClient) SubscriptionsByLabel(_ context.Context, label string, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(subscription dtos.Subscription) bool { return contains(subscription.Labels, label) })
+}
+
+func (c *SubscriptionClient) SubscriptionsByReceiver(_ context.Context, receiver string, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(subscription dtos.Subscription) bool { return subscription.Receiver == receiver })
+}
+
+func (c *SubscriptionClient) query(offset int, limit int, match func(dtos.Subscription) bool) (responses.MultiSubscriptionsResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	var matched []dtos.Subscription
+	for _, subscription := range c.subscriptions {
+		if match(subscription) {
+			matched = append(matched, subscription)
+		}
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return responses.MultiSubscriptionsResponse{}, err
+	}
+	return responses.NewMultiSubscriptionsResponse("", "", http.StatusOK, uint32(len(matched)), deepCopy(matched[start:end]).([]dtos.Subscription)), nil
+}
+
+// indexOf returns the position of the named subscription, the mutex must be held by the caller
+func (c *SubscriptionClient) indexOf(name string) int {
+	return indexOf(len(c.subscriptions), func(i int) bool { return c.subscriptions[i].Name == name })
+}
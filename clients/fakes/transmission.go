@@ -0,0 +1,113 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fakes
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+const transmissionEntity = "transmission"
+
+// TransmissionClient is an in-memory interfaces.TransmissionClient. Since the transmissions are created by the
+// notifications service, they can only be provided when creating the client.
+type TransmissionClient struct {
+	transmissions []dtos.Transmission
+	mutex         sync.RWMutex
+}
+
+// NewTransmissionClient creates an in-memory TransmissionClient holding the specified transmissions. The transmissions
+// without id or created timestamp are given one.
+func NewTransmissionClient(transmissions ...dtos.Transmission) *TransmissionClient {
+	c := &TransmissionClient{transmissions: make([]dtos.Transmission, len(transmissions))}
+	for i, transmission := range transmissions {
+		transmission.Id = newId(transmission.Id)
+		if transmission.Created == 0 {
+			transmission.Created = makeTimestamp()
+		}
+		c.transmissions[i] = deepCopy(transmission).(dtos.Transmission)
+	}
+	return c
+}
+
+func (c *TransmissionClient) TransmissionById(_ context.Context, id string) (responses.TransmissionResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	i := indexOf(len(c.transmissions), func(i int) bool { return c.transmissions[i].Id == id })
+	if i < 0 {
+		return responses.TransmissionResponse{}, notFound(transmissionEntity, "id", id)
+	}
+	return responses.NewTransmissionResponse("", "", http.StatusOK, deepCopy(c.transmissions[i]).(dtos.Transmission)), nil
+}
+
+func (c *TransmissionClient) TransmissionsByTimeRange(_ context.Context, start int, end int, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(transmission dtos.Transmission) bool {
+		return transmission.Created >= int64(start) && transmission.Created <= int64(end)
+	})
+}
+
+func (c *TransmissionClient) AllTransmissions(_ context.Context, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(dtos.Transmission) bool { return true })
+}
+
+func (c *TransmissionClient) TransmissionsByStatus(_ context.Context, status string, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(transmission dtos.Transmission) bool { return transmission.Status == status })
+}
+
+// DeleteProcessedTransmissionsByAge deletes the transmissions created more than age milliseconds ago which are not
+// pending, i.e. whose status is neither FAILED nor RESENDING
+func (c *TransmissionClient) DeleteProcessedTransmissionsByAge(_ context.Context, age int) (common.BaseResponse, errors.EdgeX) {
+	expired := makeTimestamp() - int64(age)
+	c.delete(func(transmission dtos.Transmission) bool {
+		return transmission.Status != models.Failed && transmission.Status != models.RESENDING && transmission.Created < expired
+	})
+	return okResponse(), nil
+}
+
+func (c *TransmissionClient) TransmissionsBySubscriptionName(_ context.Context, subscriptionName string, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(transmission dtos.Transmission) bool {
+		return transmission.SubscriptionName == subscriptionName
+	})
+}
+
+func (c *TransmissionClient) TransmissionsByNotificationId(_ context.Context, id string, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX) {
+	return c.query(offset, limit, func(transmission dtos.Transmission) bool { return transmission.NotificationId == id })
+}
+
+func (c *TransmissionClient) delete(match func(dtos.Transmission) bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	kept := c.transmissions[:0]
+	for _, transmission := range c.transmissions {
+		if !match(transmission) {
+			kept = append(kept, transmission)
+		}
+	}
+	c.transmissions = kept
+}
+
+func (c *TransmissionClient) query(offset int, limit int, match func(dtos.Transmission) bool) (responses.MultiTransmissionsResponse, errors.EdgeX) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	var matched []dtos.Transmission
+	for _, transmission := range c.transmissions {
+		if match(transmission) {
+			matched = append(matched, transmission)
+		}
+	}
+	start, end, err := page(len(matched), offset, limit)
+	if err != nil {
+		return responses.MultiTransmissionsResponse{}, err
+	}
+	return responses.NewMultiTransmissionsResponse("", "", http.StatusOK, uint32(len(matched)), deepCopy(matched[start:end]).([]dtos.Transmission)), nil
+}
@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"google.golang.org/grpc"
+)
+
+type commandClient struct {
+	client pb.CommandsClient
+}
+
+// NewCommandClient creates an instance of CommandClient calling the Commands service over the gRPC connection
+func NewCommandClient(conn grpc.ClientConnInterface) interfaces.CommandClient {
+	return &commandClient{client: pb.NewCommandsClient(conn)}
+}
+
+func (cc *commandClient) AllDeviceCoreCommands(ctx context.Context, offset int, limit int) (responses.MultiDeviceCoreCommandsResponse, errors.EdgeX) {
+	res, err := cc.client.AllDeviceCoreCommands(outgoingContext(ctx), &pb.PageRequest{Offset: int32(offset), Limit: int32(limit)})
+	if err != nil {
+		return responses.MultiDeviceCoreCommandsResponse{}, fromStatus(err)
+	}
+	return pb.ToMultiDeviceCoreCommandsResponseDTO(res), nil
+}
+
+func (cc *commandClient) DeviceCoreCommandsByDeviceName(ctx context.Context, deviceName string) (responses.DeviceCoreCommandResponse, errors.EdgeX) {
+	res, err := cc.client.DeviceCoreCommandsByDeviceName(outgoingContext(ctx), &pb.NameRequest{Name: deviceName})
+	if err != nil {
+		return responses.DeviceCoreCommandResponse{}, fromStatus(err)
+	}
+	return pb.ToDeviceCoreCommandResponseDTO(res), nil
+}
+
+// IssueGetCommandByName returns a nil EventResponse if the device service returns no event, e.g. when dsReturnEvent is
+// no
+func (cc *commandClient) IssueGetCommandByName(ctx context.Context, deviceName string, commandName string, dsPushEvent string, dsReturnEvent string) (*responses.EventResponse, errors.EdgeX) {
+	req := &pb.GetCommandRequest{DeviceName: deviceName, CommandName: commandName, DsPushEvent: dsPushEvent, DsReturnEvent: dsReturnEvent}
+	res, err := cc.client.IssueGetCommandByName(outgoingContext(ctx), req)
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	if res.GetBase() == nil {
+		return nil, nil
+	}
+	dto := pb.ToEventResponseDTO(res)
+	return &dto, nil
+}
+
+func (cc *commandClient) IssueSetCommandByName(ctx context.Context, deviceName string, commandName string, settings map[string]string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	objectSettings := make(map[string]interface{}, len(settings))
+	for name, value := range settings {
+		objectSettings[name] = value
+	}
+	return cc.IssueSetCommandByNameWithObject(ctx, deviceName, commandName, objectSettings)
+}
+
+func (cc *commandClient) IssueSetCommandByNameWithObject(ctx context.Context, deviceName string, commandName string, settings map[string]interface{}) (dtoCommon.BaseResponse, errors.EdgeX) {
+	msg, err := pb.FromMap(settings)
+	if err != nil {
+		return dtoCommon.BaseResponse{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to convert the settings", err)
+	}
+	res, callErr := cc.client.IssueSetCommandByName(outgoingContext(ctx), &pb.SetCommandRequest{DeviceName: deviceName, CommandName: commandName, Settings: msg})
+	if callErr != nil {
+		return dtoCommon.BaseResponse{}, fromStatus(callErr)
+	}
+	return pb.ToBaseResponseDTO(res), nil
+}
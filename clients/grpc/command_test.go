@@ -0,0 +1,112 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func newCommandClient(t *testing.T, commands *mocks.CommandClient) interfaces.CommandClient {
+	conn := newConn(t, func(server *grpc.Server) {
+		pb.RegisterCommandsServer(server, NewCommandServer(commands))
+	})
+	return NewCommandClient(conn)
+}
+
+func TestDeviceCoreCommands(t *testing.T) {
+	deviceCoreCommand := dtos.DeviceCoreCommand{
+		DeviceName:  "device1",
+		ProfileName: "profile1",
+		CoreCommands: []dtos.CoreCommand{{
+			Name:       "temperature",
+			Get:        true,
+			Path:       "/api/v2/device/name/device1/temperature",
+			Url:        "http://localhost:59882",
+			Parameters: []dtos.CoreCommandParameter{{ResourceName: "temperature", ValueType: common.ValueTypeFloat32}},
+		}},
+	}
+	commands := &mocks.CommandClient{}
+	commands.On("AllDeviceCoreCommands", mock.Anything, 0, 10).
+		Return(responses.NewMultiDeviceCoreCommandsResponse("", "", http.StatusOK, 1, []dtos.DeviceCoreCommand{deviceCoreCommand}), nil)
+	commands.On("DeviceCoreCommandsByDeviceName", mock.Anything, "device1").
+		Return(responses.NewDeviceCoreCommandResponse("", "", http.StatusOK, deviceCoreCommand), nil)
+	commands.On("DeviceCoreCommandsByDeviceName", mock.Anything, "missing").
+		Return(responses.DeviceCoreCommandResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device missing not found", nil))
+	client := newCommandClient(t, commands)
+
+	all, err := client.AllDeviceCoreCommands(context.Background(), 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), all.TotalCount)
+	assert.Equal(t, []dtos.DeviceCoreCommand{deviceCoreCommand}, all.DeviceCoreCommands)
+
+	res, err := client.DeviceCoreCommandsByDeviceName(context.Background(), "device1")
+	require.NoError(t, err)
+	assert.Equal(t, deviceCoreCommand, res.DeviceCoreCommand)
+
+	_, err = client.DeviceCoreCommandsByDeviceName(context.Background(), "missing")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestIssueGetCommandByName(t *testing.T) {
+	event := dtos.NewEvent("profile1", "device1", "temperature")
+	require.NoError(t, event.AddSimpleReading("temperature", common.ValueTypeFloat32, float32(21.5)))
+	eventResponse := responses.NewEventResponse("", "", http.StatusOK, event)
+	commands := &mocks.CommandClient{}
+	commands.On("IssueGetCommandByName", mock.Anything, "device1", "temperature", common.ValueNo, common.ValueYes).Return(&eventResponse, nil)
+	commands.On("IssueGetCommandByName", mock.Anything, "device1", "temperature", common.ValueYes, common.ValueNo).Return(nil, nil)
+	client := newCommandClient(t, commands)
+
+	res, err := client.IssueGetCommandByName(context.Background(), "device1", "temperature", common.ValueNo, common.ValueYes)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, event, res.Event)
+
+	res, err = client.IssueGetCommandByName(context.Background(), "device1", "temperature", common.ValueYes, common.ValueNo)
+	require.NoError(t, err)
+	assert.Nil(t, res, "no event is returned when the event isn't requested")
+}
+
+func TestIssueSetCommandByName(t *testing.T) {
+	commands := &mocks.CommandClient{}
+	commands.On("IssueSetCommandByNameWithObject", mock.Anything, "device1", "setpoint", map[string]interface{}{"setpoint": "25"}).
+		Return(dtoCommon.NewBaseResponse("", "", http.StatusOK), nil)
+	commands.On("IssueSetCommandByNameWithObject", mock.Anything, "device1", "schedule", map[string]interface{}{"schedule": map[string]interface{}{"start": "08:00", "enabled": true}}).
+		Return(dtoCommon.NewBaseResponse("", "", http.StatusOK), nil)
+	commands.On("IssueSetCommandByNameWithObject", mock.Anything, "device1", "locked", mock.Anything).
+		Return(dtoCommon.BaseResponse{}, errors.NewCommonEdgeX(errors.KindServiceLocked, "device1 is locked", nil))
+	client := newCommandClient(t, commands)
+
+	res, err := client.IssueSetCommandByName(context.Background(), "device1", "setpoint", map[string]string{"setpoint": "25"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	res, err = client.IssueSetCommandByNameWithObject(context.Background(), "device1", "schedule",
+		map[string]interface{}{"schedule": map[string]interface{}{"start": "08:00", "enabled": true}})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	_, err = client.IssueSetCommandByName(context.Background(), "device1", "locked", map[string]string{"locked": "true"})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceLocked, errors.Kind(err))
+	commands.AssertExpectations(t)
+}
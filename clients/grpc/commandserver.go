@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+)
+
+type commandServer struct {
+	pb.UnimplementedCommandsServer
+	commands interfaces.CommandClient
+}
+
+// NewCommandServer creates the Commands service serving the calls with the CommandClient, e.g. the HTTP client of
+// core-command
+func NewCommandServer(commands interfaces.CommandClient) pb.CommandsServer {
+	return &commandServer{commands: commands}
+}
+
+func (s *commandServer) AllDeviceCoreCommands(ctx context.Context, msg *pb.PageRequest) (*pb.MultiDeviceCoreCommandsResponse, error) {
+	res, err := s.commands.AllDeviceCoreCommands(incomingContext(ctx), int(msg.GetOffset()), int(msg.GetLimit()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromMultiDeviceCoreCommandsResponseDTO(res), nil
+}
+
+func (s *commandServer) DeviceCoreCommandsByDeviceName(ctx context.Context, msg *pb.NameRequest) (*pb.DeviceCoreCommandResponse, error) {
+	res, err := s.commands.DeviceCoreCommandsByDeviceName(incomingContext(ctx), msg.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromDeviceCoreCommandResponseDTO(res), nil
+}
+
+func (s *commandServer) IssueGetCommandByName(ctx context.Context, msg *pb.GetCommandRequest) (*pb.EventResponse, error) {
+	res, err := s.commands.IssueGetCommandByName(incomingContext(ctx), msg.GetDeviceName(), msg.GetCommandName(), msg.GetDsPushEvent(), msg.GetDsReturnEvent())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if res == nil {
+		return &pb.EventResponse{}, nil
+	}
+	event, err := pb.FromEventResponseDTO(*res)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return event, nil
+}
+
+func (s *commandServer) IssueSetCommandByName(ctx context.Context, msg *pb.SetCommandRequest) (*pb.BaseResponse, error) {
+	res, err := s.commands.IssueSetCommandByNameWithObject(incomingContext(ctx), msg.GetDeviceName(), msg.GetCommandName(), pb.ToMap(msg.GetSettings()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromBaseResponseDTO(res), nil
+}
@@ -0,0 +1,137 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package grpc provides the gRPC implementations of the EventClient, ReadingClient, DeviceClient and CommandClient
+// interfaces, calling the services defined by the .proto files of the pb package, and the servers exposing any
+// implementation of these interfaces, e.g. the HTTP clients or the in-memory fakes, as the gRPC services:
+//
+//	server := grpc.NewServer()
+//	pb.RegisterEventsServer(server, clientsGrpc.NewEventServer(events))
+//	...
+//	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+//	client := clientsGrpc.NewEventClient(conn)
+//
+// The clients return the EdgeX error of the same kind as the server, and propagate the request metadata, the
+// Correlation ID and the W3C Trace Context of the context as gRPC metadata. The AddEventStream sends the events of a
+// high-rate telemetry path over a single HTTP/2 stream instead of one call per event.
+package grpc
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/requestcontext"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/tracecontext"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus converts the EdgeX error to a gRPC status error, with an ErrorDetail so that the client returns an EdgeX
+// error of the same kind
+func toStatus(err errors.EdgeX) error {
+	s := status.New(codeMapping(err.Code()), err.Error())
+	detailed, detailErr := s.WithDetails(&pb.ErrorDetail{Kind: string(errors.Kind(err)), StatusCode: int32(err.Code()), Message: err.Error()})
+	if detailErr != nil {
+		return s.Err()
+	}
+	return detailed.Err()
+}
+
+// fromStatus converts the error of a gRPC call to an EdgeX error, of the kind of the ErrorDetail of the status if any.
+// The errors raised by gRPC itself, e.g. when the server can't be reached, are given the kind matching their code.
+func fromStatus(err error) errors.EdgeX {
+	s := status.Convert(err)
+	for _, detail := range s.Details() {
+		if errorDetail, ok := detail.(*pb.ErrorDetail); ok {
+			return errors.NewCommonEdgeX(errors.ErrKind(errorDetail.Kind), errorDetail.Message, nil)
+		}
+	}
+	return errors.NewCommonEdgeX(kindMapping(s.Code()), "failed to call the gRPC service", err)
+}
+
+// codeMapping determines the gRPC code matching the HTTP status code of an EdgeX error
+func codeMapping(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusRequestEntityTooLarge:
+		return codes.ResourceExhausted
+	case http.StatusRequestedRangeNotSatisfiable:
+		return codes.OutOfRange
+	case http.StatusLocked:
+		return codes.FailedPrecondition
+	case http.StatusForbidden, http.StatusMethodNotAllowed:
+		return codes.PermissionDenied
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable, http.StatusBadGateway:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+// kindMapping determines the EdgeX error kind matching a gRPC code
+func kindMapping(code codes.Code) errors.ErrKind {
+	switch code {
+	case codes.InvalidArgument:
+		return errors.KindContractInvalid
+	case codes.NotFound:
+		return errors.KindEntityDoesNotExist
+	case codes.AlreadyExists:
+		return errors.KindStatusConflict
+	case codes.ResourceExhausted:
+		return errors.KindLimitExceeded
+	case codes.OutOfRange:
+		return errors.KindRangeNotSatisfiable
+	case codes.FailedPrecondition:
+		return errors.KindServiceLocked
+	case codes.PermissionDenied:
+		return errors.KindNotAllowed
+	case codes.Unimplemented:
+		return errors.KindNotImplemented
+	case codes.Unavailable:
+		return errors.KindServiceUnavailable
+	default:
+		return errors.KindServerError
+	}
+}
+
+// outgoingContext returns a copy of the context carrying, as gRPC metadata, the headers the HTTP clients would send to
+// propagate the request context: the request metadata, the Correlation ID and the W3C Trace Context
+func outgoingContext(ctx context.Context) context.Context {
+	var pairs []string
+	for name, values := range requestcontext.Headers(ctx) {
+		for _, value := range values {
+			pairs = append(pairs, name, value)
+		}
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
+
+// incomingContext returns a copy of the context of a call carrying the request context propagated by the client, so
+// that the backend of the server forwards it in turn
+func incomingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	header := http.Header{}
+	for name, values := range md {
+		for _, value := range values {
+			header.Add(name, value)
+		}
+	}
+	ctx = requestcontext.Extract(ctx, header)
+	return tracecontext.Extract(ctx, header)
+}
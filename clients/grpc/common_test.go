@@ -0,0 +1,119 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/requestcontext"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/tracecontext"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newConn serves the services registered by register over an in-process bufconn listener, and returns a connection to
+// them, which is closed along with the server when the test ends
+func newConn(t *testing.T, register func(server *grpc.Server)) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	register(server)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	conn, err := grpc.DialContext(context.Background(), "bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+		server.Stop()
+	})
+	return conn
+}
+
+func TestStatusRoundTrip(t *testing.T) {
+	tests := []struct {
+		name         string
+		kind         errors.ErrKind
+		expectedCode codes.Code
+	}{
+		{"not found", errors.KindEntityDoesNotExist, codes.NotFound},
+		{"contract invalid", errors.KindContractInvalid, codes.InvalidArgument},
+		{"duplicate name", errors.KindDuplicateName, codes.AlreadyExists},
+		{"range not satisfiable", errors.KindRangeNotSatisfiable, codes.OutOfRange},
+		{"service locked", errors.KindServiceLocked, codes.FailedPrecondition},
+		{"server error", errors.KindServerError, codes.Internal},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := toStatus(errors.NewCommonEdgeX(testCase.kind, "failure", nil))
+			assert.Equal(t, testCase.expectedCode, status.Code(err))
+
+			edgexErr := fromStatus(err)
+			assert.Equal(t, testCase.kind, errors.Kind(edgexErr), "the kind of the server error is kept, even if it shares its status code with another kind")
+			assert.Equal(t, "failure", edgexErr.Message())
+		})
+	}
+}
+
+func TestFromStatusWithoutDetail(t *testing.T) {
+	err := fromStatus(status.Error(codes.Unavailable, "connection refused"))
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.Contains(t, err.Error(), "connection refused")
+
+	err = fromStatus(status.Error(codes.DeadlineExceeded, "deadline exceeded"))
+	assert.Equal(t, errors.KindServerError, errors.Kind(err))
+}
+
+func TestRequestContextPropagation(t *testing.T) {
+	events := &mocks.EventClient{}
+	events.On("DeleteByDeviceName", mock.Anything, "device1").Return(dtoCommon.NewBaseResponse("", "", 200), nil)
+	conn := newConn(t, func(server *grpc.Server) {
+		pb.RegisterEventsServer(server, NewEventServer(events))
+	})
+
+	trace := tracecontext.New()
+	ctx := requestcontext.WithTenant(requestcontext.WithCorrelationId(context.Background(), "correlation1"), "tenant1")
+	ctx = tracecontext.NewContext(ctx, trace)
+	_, err := NewEventClient(conn).DeleteByDeviceName(ctx, "device1")
+	require.NoError(t, err)
+
+	serverCtx := events.Calls[0].Arguments.Get(0).(context.Context)
+	assert.Equal(t, "correlation1", requestcontext.CorrelationId(serverCtx))
+	assert.Equal(t, "tenant1", requestcontext.Tenant(serverCtx))
+	assert.Equal(t, trace.TraceId, tracecontext.TraceIdFromContext(serverCtx))
+}
+
+func TestUnreachableServer(t *testing.T) {
+	listener := bufconn.Listen(1024)
+	require.NoError(t, listener.Close())
+	conn, err := grpc.DialContext(context.Background(), "bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, edgexErr := NewEventClient(conn).EventCount(context.Background())
+	require.Error(t, edgexErr)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(edgexErr))
+}
@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"google.golang.org/grpc"
+)
+
+type deviceClient struct {
+	client pb.DevicesClient
+}
+
+// NewDeviceClient creates an instance of DeviceClient calling the Devices service over the gRPC connection
+func NewDeviceClient(conn grpc.ClientConnInterface) interfaces.DeviceClient {
+	return &deviceClient{client: pb.NewDevicesClient(conn)}
+}
+
+func (dc *deviceClient) Add(ctx context.Context, reqs []requests.AddDeviceRequest) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	msg := &pb.AddDevicesRequest{Requests: make([]*pb.AddDeviceRequest, len(reqs))}
+	for i, req := range reqs {
+		var err errors.EdgeX
+		if msg.Requests[i], err = pb.FromAddDeviceRequestDTO(req); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	res, err := dc.client.Add(outgoingContext(ctx), msg)
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return pb.ToBaseWithIdResponseDTOs(res), nil
+}
+
+func (dc *deviceClient) Update(ctx context.Context, reqs []requests.UpdateDeviceRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
+	msg := &pb.UpdateDevicesRequest{Requests: make([]*pb.UpdateDeviceRequest, len(reqs))}
+	for i, req := range reqs {
+		var err errors.EdgeX
+		if msg.Requests[i], err = pb.FromUpdateDeviceRequestDTO(req); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	res, err := dc.client.Update(outgoingContext(ctx), msg)
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return pb.ToBaseResponseDTOs(res), nil
+}
+
+func (dc *deviceClient) AllDevices(ctx context.Context, labels []string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	res, err := dc.client.AllDevices(outgoingContext(ctx), &pb.LabelsPageRequest{Labels: labels, Offset: int32(offset), Limit: int32(limit)})
+	return devicesResponse(res, err)
+}
+
+func (dc *deviceClient) DeviceNameExists(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dc.client.DeviceNameExists(outgoingContext(ctx), &pb.NameRequest{Name: name})
+	if err != nil {
+		return dtoCommon.BaseResponse{}, fromStatus(err)
+	}
+	return pb.ToBaseResponseDTO(res), nil
+}
+
+func (dc *deviceClient) DeviceByName(ctx context.Context, name string) (responses.DeviceResponse, errors.EdgeX) {
+	res, err := dc.client.DeviceByName(outgoingContext(ctx), &pb.NameRequest{Name: name})
+	if err != nil {
+		return responses.DeviceResponse{}, fromStatus(err)
+	}
+	return pb.ToDeviceResponseDTO(res), nil
+}
+
+func (dc *deviceClient) DeleteDeviceByName(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := dc.client.DeleteDeviceByName(outgoingContext(ctx), &pb.NameRequest{Name: name})
+	if err != nil {
+		return dtoCommon.BaseResponse{}, fromStatus(err)
+	}
+	return pb.ToBaseResponseDTO(res), nil
+}
+
+func (dc *deviceClient) DevicesByProfileName(ctx context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	res, err := dc.client.DevicesByProfileName(outgoingContext(ctx), &pb.NamePageRequest{Name: name, Offset: int32(offset), Limit: int32(limit)})
+	return devicesResponse(res, err)
+}
+
+func (dc *deviceClient) DevicesByServiceName(ctx context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	res, err := dc.client.DevicesByServiceName(outgoingContext(ctx), &pb.NamePageRequest{Name: name, Offset: int32(offset), Limit: int32(limit)})
+	return devicesResponse(res, err)
+}
+
+func devicesResponse(res *pb.MultiDevicesResponse, err error) (responses.MultiDevicesResponse, errors.EdgeX) {
+	if err != nil {
+		return responses.MultiDevicesResponse{}, fromStatus(err)
+	}
+	return pb.ToMultiDevicesResponseDTO(res), nil
+}
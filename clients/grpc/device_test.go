@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/conformance"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/fakes"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestDeviceClientConformance(t *testing.T) {
+	conformance.RunDeviceClientSuite(t, func(t *testing.T) interfaces.DeviceClient {
+		conn := newConn(t, func(server *grpc.Server) {
+			pb.RegisterDevicesServer(server, NewDeviceServer(fakes.NewDeviceClient()))
+		})
+		return NewDeviceClient(conn)
+	})
+}
+
+func TestDeviceClientUpdateClearsLabels(t *testing.T) {
+	devices := fakes.NewDeviceClient(dtos.Device{
+		Name:           "device1",
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		Labels:         []string{"label1"},
+		Location:       map[string]interface{}{"building": "A"},
+		ServiceName:    "service1",
+		ProfileName:    "profile1",
+		Protocols:      map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": "localhost"}},
+	})
+	conn := newConn(t, func(server *grpc.Server) {
+		pb.RegisterDevicesServer(server, NewDeviceServer(devices))
+	})
+	client := NewDeviceClient(conn)
+
+	name, description := "device1", "updated"
+	res, err := client.Update(context.Background(), []requests.UpdateDeviceRequest{
+		requests.NewUpdateDeviceRequest(dtos.UpdateDevice{Name: &name, Description: &description}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res[0].StatusCode, res[0].Message)
+	device, err := client.DeviceByName(context.Background(), "device1")
+	require.NoError(t, err)
+	assert.Equal(t, "updated", device.Device.Description)
+	assert.Equal(t, []string{"label1"}, device.Device.Labels, "the labels absent from the update are unchanged")
+	assert.Equal(t, map[string]interface{}{"building": "A"}, device.Device.Location)
+
+	res, err = client.Update(context.Background(), []requests.UpdateDeviceRequest{
+		requests.NewUpdateDeviceRequest(dtos.UpdateDevice{Name: &name, Labels: []string{}}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res[0].StatusCode, res[0].Message)
+	device, err = client.DeviceByName(context.Background(), "device1")
+	require.NoError(t, err)
+	assert.Empty(t, device.Device.Labels, "the empty labels of the update clear the labels")
+}
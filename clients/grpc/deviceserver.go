@@ -0,0 +1,113 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+type deviceServer struct {
+	pb.UnimplementedDevicesServer
+	devices interfaces.DeviceClient
+}
+
+// NewDeviceServer creates the Devices service serving the calls with the DeviceClient, e.g. the HTTP client of
+// core-metadata or an in-memory fake. The requests are validated before being handed over to the DeviceClient, an
+// invalid request failing the whole batch as with the REST API.
+func NewDeviceServer(devices interfaces.DeviceClient) pb.DevicesServer {
+	return &deviceServer{devices: devices}
+}
+
+func (s *deviceServer) Add(ctx context.Context, msg *pb.AddDevicesRequest) (*pb.MultiBaseWithIdResponse, error) {
+	reqs := make([]requests.AddDeviceRequest, len(msg.GetRequests()))
+	for i, req := range msg.GetRequests() {
+		reqs[i] = pb.ToAddDeviceRequestDTO(req)
+		if err := reqs[i].Validate(); err != nil {
+			return nil, toStatus(errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid AddDeviceRequest %d", i), err))
+		}
+	}
+	res, err := s.devices.Add(incomingContext(ctx), reqs)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromBaseWithIdResponseDTOs(res), nil
+}
+
+func (s *deviceServer) Update(ctx context.Context, msg *pb.UpdateDevicesRequest) (*pb.MultiBaseResponse, error) {
+	reqs := make([]requests.UpdateDeviceRequest, len(msg.GetRequests()))
+	for i, req := range msg.GetRequests() {
+		reqs[i] = pb.ToUpdateDeviceRequestDTO(req)
+		if err := reqs[i].Validate(); err != nil {
+			return nil, toStatus(errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid UpdateDeviceRequest %d", i), err))
+		}
+	}
+	res, err := s.devices.Update(incomingContext(ctx), reqs)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromBaseResponseDTOs(res), nil
+}
+
+func (s *deviceServer) AllDevices(ctx context.Context, msg *pb.LabelsPageRequest) (*pb.MultiDevicesResponse, error) {
+	res, err := s.devices.AllDevices(incomingContext(ctx), msg.GetLabels(), int(msg.GetOffset()), int(msg.GetLimit()))
+	return devicesMessage(res, err)
+}
+
+func (s *deviceServer) DeviceNameExists(ctx context.Context, msg *pb.NameRequest) (*pb.BaseResponse, error) {
+	res, err := s.devices.DeviceNameExists(incomingContext(ctx), msg.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromBaseResponseDTO(res), nil
+}
+
+func (s *deviceServer) DeviceByName(ctx context.Context, msg *pb.NameRequest) (*pb.DeviceResponse, error) {
+	res, err := s.devices.DeviceByName(incomingContext(ctx), msg.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	device, err := pb.FromDeviceResponseDTO(res)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return device, nil
+}
+
+func (s *deviceServer) DeleteDeviceByName(ctx context.Context, msg *pb.NameRequest) (*pb.BaseResponse, error) {
+	res, err := s.devices.DeleteDeviceByName(incomingContext(ctx), msg.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromBaseResponseDTO(res), nil
+}
+
+func (s *deviceServer) DevicesByProfileName(ctx context.Context, msg *pb.NamePageRequest) (*pb.MultiDevicesResponse, error) {
+	res, err := s.devices.DevicesByProfileName(incomingContext(ctx), msg.GetName(), int(msg.GetOffset()), int(msg.GetLimit()))
+	return devicesMessage(res, err)
+}
+
+func (s *deviceServer) DevicesByServiceName(ctx context.Context, msg *pb.NamePageRequest) (*pb.MultiDevicesResponse, error) {
+	res, err := s.devices.DevicesByServiceName(incomingContext(ctx), msg.GetName(), int(msg.GetOffset()), int(msg.GetLimit()))
+	return devicesMessage(res, err)
+}
+
+func devicesMessage(res responses.MultiDevicesResponse, err errors.EdgeX) (*pb.MultiDevicesResponse, error) {
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	msg, err := pb.FromMultiDevicesResponseDTO(res)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return msg, nil
+}
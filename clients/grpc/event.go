@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"io"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type eventClient struct {
+	client pb.EventsClient
+}
+
+// NewEventClient creates an instance of EventClient calling the Events service over the gRPC connection
+func NewEventClient(conn grpc.ClientConnInterface) interfaces.EventClient {
+	return &eventClient{client: pb.NewEventsClient(conn)}
+}
+
+func (ec *eventClient) Add(ctx context.Context, req requests.AddEventRequest) (dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	msg, err := pb.FromAddEventRequestDTO(req)
+	if err != nil {
+		return dtoCommon.BaseWithIdResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	res, callErr := ec.client.Add(outgoingContext(ctx), msg)
+	if callErr != nil {
+		return dtoCommon.BaseWithIdResponse{}, fromStatus(callErr)
+	}
+	return pb.ToBaseWithIdResponseDTO(res), nil
+}
+
+func (ec *eventClient) AllEvents(ctx context.Context, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX) {
+	res, err := ec.client.AllEvents(outgoingContext(ctx), &pb.PageRequest{Offset: int32(offset), Limit: int32(limit)})
+	if err != nil {
+		return responses.MultiEventsResponse{}, fromStatus(err)
+	}
+	return pb.ToMultiEventsResponseDTO(res), nil
+}
+
+func (ec *eventClient) EventCount(ctx context.Context) (dtoCommon.CountResponse, errors.EdgeX) {
+	res, err := ec.client.EventCount(outgoingContext(ctx), &emptypb.Empty{})
+	if err != nil {
+		return dtoCommon.CountResponse{}, fromStatus(err)
+	}
+	return pb.ToCountResponseDTO(res), nil
+}
+
+func (ec *eventClient) EventCountByDeviceName(ctx context.Context, name string) (dtoCommon.CountResponse, errors.EdgeX) {
+	res, err := ec.client.EventCountByDeviceName(outgoingContext(ctx), &pb.NameRequest{Name: name})
+	if err != nil {
+		return dtoCommon.CountResponse{}, fromStatus(err)
+	}
+	return pb.ToCountResponseDTO(res), nil
+}
+
+func (ec *eventClient) EventsByDeviceName(ctx context.Context, name string, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX) {
+	res, err := ec.client.EventsByDeviceName(outgoingContext(ctx), &pb.NamePageRequest{Name: name, Offset: int32(offset), Limit: int32(limit)})
+	if err != nil {
+		return responses.MultiEventsResponse{}, fromStatus(err)
+	}
+	return pb.ToMultiEventsResponseDTO(res), nil
+}
+
+func (ec *eventClient) DeleteByDeviceName(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := ec.client.DeleteByDeviceName(outgoingContext(ctx), &pb.NameRequest{Name: name})
+	if err != nil {
+		return dtoCommon.BaseResponse{}, fromStatus(err)
+	}
+	return pb.ToBaseResponseDTO(res), nil
+}
+
+func (ec *eventClient) EventsByTimeRange(ctx context.Context, start, end, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX) {
+	req := &pb.TimeRangeRequest{Start: int64(start), End: int64(end), Offset: int32(offset), Limit: int32(limit)}
+	res, err := ec.client.EventsByTimeRange(outgoingContext(ctx), req)
+	if err != nil {
+		return responses.MultiEventsResponse{}, fromStatus(err)
+	}
+	return pb.ToMultiEventsResponseDTO(res), nil
+}
+
+func (ec *eventClient) DeleteByAge(ctx context.Context, age int) (dtoCommon.BaseResponse, errors.EdgeX) {
+	res, err := ec.client.DeleteByAge(outgoingContext(ctx), &pb.AgeRequest{Age: int64(age)})
+	if err != nil {
+		return dtoCommon.BaseResponse{}, fromStatus(err)
+	}
+	return pb.ToBaseResponseDTO(res), nil
+}
+
+// AddEventStream adds events over a single gRPC stream of the Events service, for the high-rate telemetry paths where
+// a call per event costs too much. The events can be sent without waiting for the responses, which come back in the
+// order the events are sent, a failed event not ending the stream. Send and Recv can be called from two goroutines,
+// but neither of them concurrently with itself.
+type AddEventStream struct {
+	stream pb.Events_AddStreamClient
+}
+
+// NewAddEventStream opens a stream of the Events service over the gRPC connection, which lasts until CloseSend is
+// called and the remaining responses are received, or the context is done
+func NewAddEventStream(ctx context.Context, conn grpc.ClientConnInterface) (*AddEventStream, errors.EdgeX) {
+	stream, err := pb.NewEventsClient(conn).AddStream(outgoingContext(ctx))
+	if err != nil {
+		return nil, fromStatus(err)
+	}
+	return &AddEventStream{stream: stream}, nil
+}
+
+// Send sends the event to add. Once the stream is broken, Send fails with a KindCommunicationError error and the
+// error of the stream is returned by Recv.
+func (s *AddEventStream) Send(req requests.AddEventRequest) errors.EdgeX {
+	msg, err := pb.FromAddEventRequestDTO(req)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if sendErr := s.stream.Send(msg); sendErr != nil {
+		return errors.NewCommonEdgeX(errors.KindCommunicationError, "failed to send the event, the stream is broken", sendErr)
+	}
+	return nil
+}
+
+// Recv receives the response of the next event sent. It returns io.EOF once the responses of all the events are
+// received after CloseSend, and an EdgeX error if the stream is broken.
+func (s *AddEventStream) Recv() (dtoCommon.BaseWithIdResponse, error) {
+	res, err := s.stream.Recv()
+	if err == io.EOF {
+		return dtoCommon.BaseWithIdResponse{}, io.EOF
+	}
+	if err != nil {
+		return dtoCommon.BaseWithIdResponse{}, fromStatus(err)
+	}
+	return pb.ToBaseWithIdResponseDTO(res), nil
+}
+
+// CloseSend tells the server that no more event is sent, the responses of the events sent being still received by Recv
+func (s *AddEventStream) CloseSend() errors.EdgeX {
+	if err := s.stream.CloseSend(); err != nil {
+		return fromStatus(err)
+	}
+	return nil
+}
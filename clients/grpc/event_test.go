@@ -0,0 +1,86 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/conformance"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/fakes"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestEventClientConformance(t *testing.T) {
+	conformance.RunEventClientSuite(t, func(t *testing.T) interfaces.EventClient {
+		conn := newConn(t, func(server *grpc.Server) {
+			pb.RegisterEventsServer(server, NewEventServer(fakes.NewEventClient()))
+		})
+		return NewEventClient(conn)
+	})
+}
+
+func TestAddEventStream(t *testing.T) {
+	events := fakes.NewEventClient()
+	conn := newConn(t, func(server *grpc.Server) {
+		pb.RegisterEventsServer(server, NewEventServer(events))
+	})
+	stream, err := NewAddEventStream(context.Background(), conn)
+	require.NoError(t, err)
+
+	valid := dtos.NewEvent("profile1", "device1", "source1")
+	require.NoError(t, valid.AddSimpleReading("temperature", common.ValueTypeInt32, int32(20)))
+	invalid := dtos.NewEvent("profile1", "device1", "source1")
+	reqs := []requests.AddEventRequest{
+		requests.NewAddEventRequest(valid),
+		requests.NewAddEventRequest(invalid),
+		requests.NewAddEventRequest(valid),
+	}
+	reqs[2].Event.Id = "3e7ba2a4-a6c3-4ec2-9dc5-3e8a93d7e1b5"
+	for _, req := range reqs {
+		require.NoError(t, stream.Send(req), "the events are sent without waiting for the responses")
+	}
+	require.NoError(t, stream.CloseSend())
+
+	var statusCodes []int
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		statusCodes = append(statusCodes, res.StatusCode)
+	}
+	assert.Equal(t, []int{http.StatusCreated, http.StatusBadRequest, http.StatusCreated}, statusCodes,
+		"the responses come in the order of the events, the invalid event not ending the stream")
+	count, err := events.EventCount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), count.Count)
+}
+
+func TestAddEventStreamCanceled(t *testing.T) {
+	conn := newConn(t, func(server *grpc.Server) {
+		pb.RegisterEventsServer(server, NewEventServer(fakes.NewEventClient()))
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := NewAddEventStream(ctx, conn)
+	require.NoError(t, err)
+	cancel()
+
+	_, recvErr := stream.Recv()
+	require.Error(t, recvErr)
+	assert.NotEqual(t, io.EOF, recvErr)
+}
@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"io"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type eventServer struct {
+	pb.UnimplementedEventsServer
+	events interfaces.EventClient
+}
+
+// NewEventServer creates the Events service serving the calls with the EventClient, e.g. the HTTP client of core-data
+// or an in-memory fake. The requests are validated before being handed over to the EventClient.
+func NewEventServer(events interfaces.EventClient) pb.EventsServer {
+	return &eventServer{events: events}
+}
+
+func (s *eventServer) Add(ctx context.Context, msg *pb.AddEventRequest) (*pb.BaseWithIdResponse, error) {
+	res, err := s.add(incomingContext(ctx), msg)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromBaseWithIdResponseDTO(res), nil
+}
+
+// AddStream adds the events of the stream one at a time, a failed event being answered with a response holding the
+// status code and the message of the error
+func (s *eventServer) AddStream(stream pb.Events_AddStreamServer) error {
+	ctx := incomingContext(stream.Context())
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		res, edgexErr := s.add(ctx, msg)
+		if edgexErr != nil {
+			res = dtoCommon.NewBaseWithIdResponse(msg.GetBase().GetRequestId(), edgexErr.Error(), edgexErr.Code(), "")
+		}
+		if err = stream.Send(pb.FromBaseWithIdResponseDTO(res)); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *eventServer) add(ctx context.Context, msg *pb.AddEventRequest) (dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	req := pb.ToAddEventRequestDTO(msg)
+	if err := req.Validate(); err != nil {
+		return dtoCommon.BaseWithIdResponse{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid AddEventRequest", err)
+	}
+	return s.events.Add(ctx, req)
+}
+
+func (s *eventServer) AllEvents(ctx context.Context, msg *pb.PageRequest) (*pb.MultiEventsResponse, error) {
+	res, err := s.events.AllEvents(incomingContext(ctx), int(msg.GetOffset()), int(msg.GetLimit()))
+	return eventsMessage(res, err)
+}
+
+func (s *eventServer) EventCount(ctx context.Context, _ *emptypb.Empty) (*pb.CountResponse, error) {
+	res, err := s.events.EventCount(incomingContext(ctx))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromCountResponseDTO(res), nil
+}
+
+func (s *eventServer) EventCountByDeviceName(ctx context.Context, msg *pb.NameRequest) (*pb.CountResponse, error) {
+	res, err := s.events.EventCountByDeviceName(incomingContext(ctx), msg.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromCountResponseDTO(res), nil
+}
+
+func (s *eventServer) EventsByDeviceName(ctx context.Context, msg *pb.NamePageRequest) (*pb.MultiEventsResponse, error) {
+	res, err := s.events.EventsByDeviceName(incomingContext(ctx), msg.GetName(), int(msg.GetOffset()), int(msg.GetLimit()))
+	return eventsMessage(res, err)
+}
+
+func (s *eventServer) DeleteByDeviceName(ctx context.Context, msg *pb.NameRequest) (*pb.BaseResponse, error) {
+	res, err := s.events.DeleteByDeviceName(incomingContext(ctx), msg.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromBaseResponseDTO(res), nil
+}
+
+func (s *eventServer) EventsByTimeRange(ctx context.Context, msg *pb.TimeRangeRequest) (*pb.MultiEventsResponse, error) {
+	res, err := s.events.EventsByTimeRange(incomingContext(ctx), int(msg.GetStart()), int(msg.GetEnd()), int(msg.GetOffset()), int(msg.GetLimit()))
+	return eventsMessage(res, err)
+}
+
+func (s *eventServer) DeleteByAge(ctx context.Context, msg *pb.AgeRequest) (*pb.BaseResponse, error) {
+	res, err := s.events.DeleteByAge(incomingContext(ctx), int(msg.GetAge()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromBaseResponseDTO(res), nil
+}
+
+func eventsMessage(res responses.MultiEventsResponse, err errors.EdgeX) (*pb.MultiEventsResponse, error) {
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	msg, err := pb.FromMultiEventsResponseDTO(res)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return msg, nil
+}
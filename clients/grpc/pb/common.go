@@ -0,0 +1,152 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	"encoding/json"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// FromBaseRequestDTO converts the BaseRequest DTO to its protobuf message
+func FromBaseRequestDTO(dto dtoCommon.BaseRequest) *BaseRequest {
+	return &BaseRequest{ApiVersion: dto.ApiVersion, RequestId: dto.RequestId}
+}
+
+// ToBaseRequestDTO converts the BaseRequest protobuf message to its DTO
+func ToBaseRequestDTO(msg *BaseRequest) dtoCommon.BaseRequest {
+	return dtoCommon.BaseRequest{
+		Versionable: dtoCommon.Versionable{ApiVersion: msg.GetApiVersion()},
+		RequestId:   msg.GetRequestId(),
+	}
+}
+
+// FromBaseResponseDTO converts the BaseResponse DTO to its protobuf message
+func FromBaseResponseDTO(dto dtoCommon.BaseResponse) *BaseResponse {
+	return &BaseResponse{
+		ApiVersion: dto.ApiVersion,
+		RequestId:  dto.RequestId,
+		Message:    dto.Message,
+		StatusCode: int32(dto.StatusCode),
+	}
+}
+
+// ToBaseResponseDTO converts the BaseResponse protobuf message to its DTO
+func ToBaseResponseDTO(msg *BaseResponse) dtoCommon.BaseResponse {
+	return dtoCommon.BaseResponse{
+		Versionable: dtoCommon.Versionable{ApiVersion: msg.GetApiVersion()},
+		RequestId:   msg.GetRequestId(),
+		Message:     msg.GetMessage(),
+		StatusCode:  int(msg.GetStatusCode()),
+	}
+}
+
+// FromBaseResponseDTOs converts the BaseResponse DTOs of a batch request to their protobuf message
+func FromBaseResponseDTOs(dtos []dtoCommon.BaseResponse) *MultiBaseResponse {
+	msg := &MultiBaseResponse{Responses: make([]*BaseResponse, len(dtos))}
+	for i, dto := range dtos {
+		msg.Responses[i] = FromBaseResponseDTO(dto)
+	}
+	return msg
+}
+
+// ToBaseResponseDTOs converts the MultiBaseResponse protobuf message to the BaseResponse DTOs of a batch request
+func ToBaseResponseDTOs(msg *MultiBaseResponse) []dtoCommon.BaseResponse {
+	dtos := make([]dtoCommon.BaseResponse, len(msg.GetResponses()))
+	for i, res := range msg.GetResponses() {
+		dtos[i] = ToBaseResponseDTO(res)
+	}
+	return dtos
+}
+
+// FromBaseWithIdResponseDTO converts the BaseWithIdResponse DTO to its protobuf message
+func FromBaseWithIdResponseDTO(dto dtoCommon.BaseWithIdResponse) *BaseWithIdResponse {
+	return &BaseWithIdResponse{Base: FromBaseResponseDTO(dto.BaseResponse), Id: dto.Id}
+}
+
+// ToBaseWithIdResponseDTO converts the BaseWithIdResponse protobuf message to its DTO
+func ToBaseWithIdResponseDTO(msg *BaseWithIdResponse) dtoCommon.BaseWithIdResponse {
+	return dtoCommon.BaseWithIdResponse{BaseResponse: ToBaseResponseDTO(msg.GetBase()), Id: msg.GetId()}
+}
+
+// FromBaseWithIdResponseDTOs converts the BaseWithIdResponse DTOs of a batch Add request to their protobuf message
+func FromBaseWithIdResponseDTOs(dtos []dtoCommon.BaseWithIdResponse) *MultiBaseWithIdResponse {
+	msg := &MultiBaseWithIdResponse{Responses: make([]*BaseWithIdResponse, len(dtos))}
+	for i, dto := range dtos {
+		msg.Responses[i] = FromBaseWithIdResponseDTO(dto)
+	}
+	return msg
+}
+
+// ToBaseWithIdResponseDTOs converts the MultiBaseWithIdResponse protobuf message to the BaseWithIdResponse DTOs of a
+// batch Add request
+func ToBaseWithIdResponseDTOs(msg *MultiBaseWithIdResponse) []dtoCommon.BaseWithIdResponse {
+	dtos := make([]dtoCommon.BaseWithIdResponse, len(msg.GetResponses()))
+	for i, res := range msg.GetResponses() {
+		dtos[i] = ToBaseWithIdResponseDTO(res)
+	}
+	return dtos
+}
+
+// FromCountResponseDTO converts the CountResponse DTO to its protobuf message
+func FromCountResponseDTO(dto dtoCommon.CountResponse) *CountResponse {
+	return &CountResponse{Base: FromBaseResponseDTO(dto.BaseResponse), Count: dto.Count}
+}
+
+// ToCountResponseDTO converts the CountResponse protobuf message to its DTO
+func ToCountResponseDTO(msg *CountResponse) dtoCommon.CountResponse {
+	return dtoCommon.CountResponse{BaseResponse: ToBaseResponseDTO(msg.GetBase()), Count: msg.GetCount()}
+}
+
+// toValue converts the value to a protobuf Value the way encoding/json marshals it, so that any value of a DTO field
+// typed interface{} can be sent, e.g. a struct or a map[string]string. A nil value is converted to a nil Value.
+func toValue(v interface{}) (*structpb.Value, errors.EdgeX) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the value to JSON", err)
+	}
+	value := &structpb.Value{}
+	if err = protojson.Unmarshal(data, value); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to convert the value to a protobuf Value", err)
+	}
+	return value, nil
+}
+
+// fromValue converts the protobuf Value the way encoding/json unmarshals a value into an interface{}, e.g. the numbers
+// to float64
+func fromValue(value *structpb.Value) interface{} {
+	if value == nil {
+		return nil
+	}
+	return value.AsInterface()
+}
+
+// FromMap converts the map, e.g. the tags of an event, to a protobuf Struct, a nil map being converted to a nil Struct
+func FromMap(m map[string]interface{}) (*structpb.Struct, errors.EdgeX) {
+	if m == nil {
+		return nil, nil
+	}
+	value, err := toValue(m)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return value.GetStructValue(), nil
+}
+
+// ToMap converts the protobuf Struct to a map, a nil Struct being converted to a nil map
+func ToMap(s *structpb.Struct) map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+	return s.AsMap()
+}
@@ -0,0 +1,62 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToValue(t *testing.T) {
+	type location struct {
+		Building string `json:"building"`
+		Floor    int    `json:"floor"`
+	}
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{"nil", nil, nil},
+		{"string", "value", "value"},
+		{"number", 42, float64(42)},
+		{"typed map", map[string]string{"key": "value"}, map[string]interface{}{"key": "value"}},
+		{"struct", location{Building: "A", Floor: 2}, map[string]interface{}{"building": "A", "floor": float64(2)}},
+		{"slice", []int{1, 2}, []interface{}{float64(1), float64(2)}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			value, err := toValue(testCase.value)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, fromValue(value), "the value is converted the way encoding/json would")
+		})
+	}
+}
+
+func TestToValueUnsupported(t *testing.T) {
+	_, err := toValue(make(chan int))
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestFromMap(t *testing.T) {
+	s, err := FromMap(nil)
+	require.NoError(t, err)
+	assert.Nil(t, s)
+	assert.Nil(t, ToMap(s))
+
+	s, err = FromMap(map[string]interface{}{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{}, ToMap(s), "an empty map isn't converted to nil")
+
+	s, err = FromMap(map[string]interface{}{"tag": "value", "nested": map[string]interface{}{"enabled": true}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"tag": "value", "nested": map[string]interface{}{"enabled": true}}, ToMap(s))
+}
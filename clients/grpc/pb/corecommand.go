@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+)
+
+// FromDeviceCoreCommandDTO converts the DeviceCoreCommand DTO to its protobuf message
+func FromDeviceCoreCommandDTO(dto dtos.DeviceCoreCommand) *DeviceCoreCommand {
+	commands := make([]*CoreCommand, len(dto.CoreCommands))
+	for i, command := range dto.CoreCommands {
+		parameters := make([]*CoreCommandParameter, len(command.Parameters))
+		for j, parameter := range command.Parameters {
+			parameters[j] = &CoreCommandParameter{ResourceName: parameter.ResourceName, ValueType: parameter.ValueType}
+		}
+		commands[i] = &CoreCommand{
+			Name:       command.Name,
+			Get:        command.Get,
+			Set:        command.Set,
+			Path:       command.Path,
+			Url:        command.Url,
+			Parameters: parameters,
+		}
+	}
+	return &DeviceCoreCommand{DeviceName: dto.DeviceName, ProfileName: dto.ProfileName, CoreCommands: commands}
+}
+
+// ToDeviceCoreCommandDTO converts the DeviceCoreCommand protobuf message to its DTO
+func ToDeviceCoreCommandDTO(msg *DeviceCoreCommand) dtos.DeviceCoreCommand {
+	commands := make([]dtos.CoreCommand, len(msg.GetCoreCommands()))
+	for i, command := range msg.GetCoreCommands() {
+		parameters := make([]dtos.CoreCommandParameter, len(command.GetParameters()))
+		for j, parameter := range command.GetParameters() {
+			parameters[j] = dtos.CoreCommandParameter{ResourceName: parameter.GetResourceName(), ValueType: parameter.GetValueType()}
+		}
+		commands[i] = dtos.CoreCommand{
+			Name:       command.GetName(),
+			Get:        command.GetGet(),
+			Set:        command.GetSet(),
+			Path:       command.GetPath(),
+			Url:        command.GetUrl(),
+			Parameters: parameters,
+		}
+	}
+	return dtos.DeviceCoreCommand{DeviceName: msg.GetDeviceName(), ProfileName: msg.GetProfileName(), CoreCommands: commands}
+}
+
+// FromDeviceCoreCommandResponseDTO converts the DeviceCoreCommandResponse DTO to its protobuf message
+func FromDeviceCoreCommandResponseDTO(dto responses.DeviceCoreCommandResponse) *DeviceCoreCommandResponse {
+	return &DeviceCoreCommandResponse{
+		Base:              FromBaseResponseDTO(dto.BaseResponse),
+		DeviceCoreCommand: FromDeviceCoreCommandDTO(dto.DeviceCoreCommand),
+	}
+}
+
+// ToDeviceCoreCommandResponseDTO converts the DeviceCoreCommandResponse protobuf message to its DTO
+func ToDeviceCoreCommandResponseDTO(msg *DeviceCoreCommandResponse) responses.DeviceCoreCommandResponse {
+	return responses.DeviceCoreCommandResponse{
+		BaseResponse:      ToBaseResponseDTO(msg.GetBase()),
+		DeviceCoreCommand: ToDeviceCoreCommandDTO(msg.GetDeviceCoreCommand()),
+	}
+}
+
+// FromMultiDeviceCoreCommandsResponseDTO converts the MultiDeviceCoreCommandsResponse DTO to its protobuf message
+func FromMultiDeviceCoreCommandsResponseDTO(dto responses.MultiDeviceCoreCommandsResponse) *MultiDeviceCoreCommandsResponse {
+	commands := make([]*DeviceCoreCommand, len(dto.DeviceCoreCommands))
+	for i, command := range dto.DeviceCoreCommands {
+		commands[i] = FromDeviceCoreCommandDTO(command)
+	}
+	return &MultiDeviceCoreCommandsResponse{
+		Base:               FromBaseResponseDTO(dto.BaseResponse),
+		TotalCount:         dto.TotalCount,
+		DeviceCoreCommands: commands,
+	}
+}
+
+// ToMultiDeviceCoreCommandsResponseDTO converts the MultiDeviceCoreCommandsResponse protobuf message to its DTO
+func ToMultiDeviceCoreCommandsResponseDTO(msg *MultiDeviceCoreCommandsResponse) responses.MultiDeviceCoreCommandsResponse {
+	commands := make([]dtos.DeviceCoreCommand, len(msg.GetDeviceCoreCommands()))
+	for i, command := range msg.GetDeviceCoreCommands() {
+		commands[i] = ToDeviceCoreCommandDTO(command)
+	}
+	return responses.MultiDeviceCoreCommandsResponse{
+		BaseWithTotalCountResponse: dtoCommon.BaseWithTotalCountResponse{
+			BaseResponse: ToBaseResponseDTO(msg.GetBase()),
+			TotalCount:   msg.GetTotalCount(),
+		},
+		DeviceCoreCommands: commands,
+	}
+}
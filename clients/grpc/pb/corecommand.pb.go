@@ -0,0 +1,760 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: corecommand.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DeviceCoreCommand is the counterpart of the dtos.DeviceCoreCommand DTO.
+type DeviceCoreCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceName   string         `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	ProfileName  string         `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	CoreCommands []*CoreCommand `protobuf:"bytes,3,rep,name=core_commands,json=coreCommands,proto3" json:"core_commands,omitempty"`
+}
+
+func (x *DeviceCoreCommand) Reset() {
+	*x = DeviceCoreCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_corecommand_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceCoreCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceCoreCommand) ProtoMessage() {}
+
+func (x *DeviceCoreCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_corecommand_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceCoreCommand.ProtoReflect.Descriptor instead.
+func (*DeviceCoreCommand) Descriptor() ([]byte, []int) {
+	return file_corecommand_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeviceCoreCommand) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *DeviceCoreCommand) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *DeviceCoreCommand) GetCoreCommands() []*CoreCommand {
+	if x != nil {
+		return x.CoreCommands
+	}
+	return nil
+}
+
+// CoreCommand is the counterpart of the dtos.CoreCommand DTO.
+type CoreCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Get        bool                    `protobuf:"varint,2,opt,name=get,proto3" json:"get,omitempty"`
+	Set        bool                    `protobuf:"varint,3,opt,name=set,proto3" json:"set,omitempty"`
+	Path       string                  `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Url        string                  `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	Parameters []*CoreCommandParameter `protobuf:"bytes,6,rep,name=parameters,proto3" json:"parameters,omitempty"`
+}
+
+func (x *CoreCommand) Reset() {
+	*x = CoreCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_corecommand_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoreCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoreCommand) ProtoMessage() {}
+
+func (x *CoreCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_corecommand_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoreCommand.ProtoReflect.Descriptor instead.
+func (*CoreCommand) Descriptor() ([]byte, []int) {
+	return file_corecommand_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CoreCommand) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CoreCommand) GetGet() bool {
+	if x != nil {
+		return x.Get
+	}
+	return false
+}
+
+func (x *CoreCommand) GetSet() bool {
+	if x != nil {
+		return x.Set
+	}
+	return false
+}
+
+func (x *CoreCommand) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CoreCommand) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CoreCommand) GetParameters() []*CoreCommandParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+// CoreCommandParameter is the counterpart of the dtos.CoreCommandParameter DTO.
+type CoreCommandParameter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceName string `protobuf:"bytes,1,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	ValueType    string `protobuf:"bytes,2,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
+}
+
+func (x *CoreCommandParameter) Reset() {
+	*x = CoreCommandParameter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_corecommand_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoreCommandParameter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoreCommandParameter) ProtoMessage() {}
+
+func (x *CoreCommandParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_corecommand_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoreCommandParameter.ProtoReflect.Descriptor instead.
+func (*CoreCommandParameter) Descriptor() ([]byte, []int) {
+	return file_corecommand_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CoreCommandParameter) GetResourceName() string {
+	if x != nil {
+		return x.ResourceName
+	}
+	return ""
+}
+
+func (x *CoreCommandParameter) GetValueType() string {
+	if x != nil {
+		return x.ValueType
+	}
+	return ""
+}
+
+// DeviceCoreCommandResponse is the counterpart of the responses.DeviceCoreCommandResponse DTO.
+type DeviceCoreCommandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base              *BaseResponse      `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DeviceCoreCommand *DeviceCoreCommand `protobuf:"bytes,2,opt,name=device_core_command,json=deviceCoreCommand,proto3" json:"device_core_command,omitempty"`
+}
+
+func (x *DeviceCoreCommandResponse) Reset() {
+	*x = DeviceCoreCommandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_corecommand_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceCoreCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceCoreCommandResponse) ProtoMessage() {}
+
+func (x *DeviceCoreCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_corecommand_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceCoreCommandResponse.ProtoReflect.Descriptor instead.
+func (*DeviceCoreCommandResponse) Descriptor() ([]byte, []int) {
+	return file_corecommand_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeviceCoreCommandResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DeviceCoreCommandResponse) GetDeviceCoreCommand() *DeviceCoreCommand {
+	if x != nil {
+		return x.DeviceCoreCommand
+	}
+	return nil
+}
+
+// MultiDeviceCoreCommandsResponse is the counterpart of the responses.MultiDeviceCoreCommandsResponse DTO.
+type MultiDeviceCoreCommandsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base               *BaseResponse        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TotalCount         uint32               `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	DeviceCoreCommands []*DeviceCoreCommand `protobuf:"bytes,3,rep,name=device_core_commands,json=deviceCoreCommands,proto3" json:"device_core_commands,omitempty"`
+}
+
+func (x *MultiDeviceCoreCommandsResponse) Reset() {
+	*x = MultiDeviceCoreCommandsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_corecommand_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiDeviceCoreCommandsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiDeviceCoreCommandsResponse) ProtoMessage() {}
+
+func (x *MultiDeviceCoreCommandsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_corecommand_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiDeviceCoreCommandsResponse.ProtoReflect.Descriptor instead.
+func (*MultiDeviceCoreCommandsResponse) Descriptor() ([]byte, []int) {
+	return file_corecommand_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MultiDeviceCoreCommandsResponse) GetBase() *BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *MultiDeviceCoreCommandsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *MultiDeviceCoreCommandsResponse) GetDeviceCoreCommands() []*DeviceCoreCommand {
+	if x != nil {
+		return x.DeviceCoreCommands
+	}
+	return nil
+}
+
+// GetCommandRequest issues a read command. The ds_push_event and ds_return_event parameters take the yes and no values
+// of the REST API.
+type GetCommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceName    string `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	CommandName   string `protobuf:"bytes,2,opt,name=command_name,json=commandName,proto3" json:"command_name,omitempty"`
+	DsPushEvent   string `protobuf:"bytes,3,opt,name=ds_push_event,json=dsPushEvent,proto3" json:"ds_push_event,omitempty"`
+	DsReturnEvent string `protobuf:"bytes,4,opt,name=ds_return_event,json=dsReturnEvent,proto3" json:"ds_return_event,omitempty"`
+}
+
+func (x *GetCommandRequest) Reset() {
+	*x = GetCommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_corecommand_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommandRequest) ProtoMessage() {}
+
+func (x *GetCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_corecommand_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommandRequest.ProtoReflect.Descriptor instead.
+func (*GetCommandRequest) Descriptor() ([]byte, []int) {
+	return file_corecommand_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCommandRequest) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *GetCommandRequest) GetCommandName() string {
+	if x != nil {
+		return x.CommandName
+	}
+	return ""
+}
+
+func (x *GetCommandRequest) GetDsPushEvent() string {
+	if x != nil {
+		return x.DsPushEvent
+	}
+	return ""
+}
+
+func (x *GetCommandRequest) GetDsReturnEvent() string {
+	if x != nil {
+		return x.DsReturnEvent
+	}
+	return ""
+}
+
+// SetCommandRequest issues a write command with the settings of the resources.
+type SetCommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceName  string           `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	CommandName string           `protobuf:"bytes,2,opt,name=command_name,json=commandName,proto3" json:"command_name,omitempty"`
+	Settings    *structpb.Struct `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *SetCommandRequest) Reset() {
+	*x = SetCommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_corecommand_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCommandRequest) ProtoMessage() {}
+
+func (x *SetCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_corecommand_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCommandRequest.ProtoReflect.Descriptor instead.
+func (*SetCommandRequest) Descriptor() ([]byte, []int) {
+	return file_corecommand_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetCommandRequest) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *SetCommandRequest) GetCommandName() string {
+	if x != nil {
+		return x.CommandName
+	}
+	return ""
+}
+
+func (x *SetCommandRequest) GetSettings() *structpb.Struct {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+var File_corecommand_proto protoreflect.FileDescriptor
+
+var file_corecommand_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x63, 0x6f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x08, 0x65, 0x64, 0x67, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x1a, 0x0c, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x63, 0x6f, 0x72,
+	0x65, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x65, 0x64, 0x67,
+	0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x52, 0x0c, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22,
+	0xab, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x03, 0x67, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x3e, 0x0a,
+	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x72,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x5a, 0x0a,
+	0x14, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x19, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x78, 0x2e, 0x76, 0x32,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x62,
+	0x61, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x13, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x11, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x22, 0xbd, 0x01, 0x0a, 0x1f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x4d, 0x0a, 0x14, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x72, 0x65,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x12, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x22, 0xa3, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x73,
+	0x5f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x73, 0x50, 0x75, 0x73, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x64, 0x73, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x33, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x32, 0xe0, 0x02, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x73, 0x12, 0x59, 0x0a, 0x15, 0x41, 0x6c, 0x6c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x15, 0x2e, 0x65, 0x64,
+	0x67, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
+	0x1e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x42, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x15, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x78, 0x2e, 0x76,
+	0x32, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x15, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x42, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x15, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x42, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x53,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x64, 0x67, 0x65, 0x78, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x72, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x6d, 0x6f, 0x64, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2d,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_corecommand_proto_rawDescOnce sync.Once
+	file_corecommand_proto_rawDescData = file_corecommand_proto_rawDesc
+)
+
+func file_corecommand_proto_rawDescGZIP() []byte {
+	file_corecommand_proto_rawDescOnce.Do(func() {
+		file_corecommand_proto_rawDescData = protoimpl.X.CompressGZIP(file_corecommand_proto_rawDescData)
+	})
+	return file_corecommand_proto_rawDescData
+}
+
+var file_corecommand_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_corecommand_proto_goTypes = []interface{}{
+	(*DeviceCoreCommand)(nil),               // 0: edgex.v2.DeviceCoreCommand
+	(*CoreCommand)(nil),                     // 1: edgex.v2.CoreCommand
+	(*CoreCommandParameter)(nil),            // 2: edgex.v2.CoreCommandParameter
+	(*DeviceCoreCommandResponse)(nil),       // 3: edgex.v2.DeviceCoreCommandResponse
+	(*MultiDeviceCoreCommandsResponse)(nil), // 4: edgex.v2.MultiDeviceCoreCommandsResponse
+	(*GetCommandRequest)(nil),               // 5: edgex.v2.GetCommandRequest
+	(*SetCommandRequest)(nil),               // 6: edgex.v2.SetCommandRequest
+	(*BaseResponse)(nil),                    // 7: edgex.v2.BaseResponse
+	(*structpb.Struct)(nil),                 // 8: google.protobuf.Struct
+	(*PageRequest)(nil),                     // 9: edgex.v2.PageRequest
+	(*NameRequest)(nil),                     // 10: edgex.v2.NameRequest
+	(*EventResponse)(nil),                   // 11: edgex.v2.EventResponse
+}
+var file_corecommand_proto_depIdxs = []int32{
+	1,  // 0: edgex.v2.DeviceCoreCommand.core_commands:type_name -> edgex.v2.CoreCommand
+	2,  // 1: edgex.v2.CoreCommand.parameters:type_name -> edgex.v2.CoreCommandParameter
+	7,  // 2: edgex.v2.DeviceCoreCommandResponse.base:type_name -> edgex.v2.BaseResponse
+	0,  // 3: edgex.v2.DeviceCoreCommandResponse.device_core_command:type_name -> edgex.v2.DeviceCoreCommand
+	7,  // 4: edgex.v2.MultiDeviceCoreCommandsResponse.base:type_name -> edgex.v2.BaseResponse
+	0,  // 5: edgex.v2.MultiDeviceCoreCommandsResponse.device_core_commands:type_name -> edgex.v2.DeviceCoreCommand
+	8,  // 6: edgex.v2.SetCommandRequest.settings:type_name -> google.protobuf.Struct
+	9,  // 7: edgex.v2.Commands.AllDeviceCoreCommands:input_type -> edgex.v2.PageRequest
+	10, // 8: edgex.v2.Commands.DeviceCoreCommandsByDeviceName:input_type -> edgex.v2.NameRequest
+	5,  // 9: edgex.v2.Commands.IssueGetCommandByName:input_type -> edgex.v2.GetCommandRequest
+	6,  // 10: edgex.v2.Commands.IssueSetCommandByName:input_type -> edgex.v2.SetCommandRequest
+	4,  // 11: edgex.v2.Commands.AllDeviceCoreCommands:output_type -> edgex.v2.MultiDeviceCoreCommandsResponse
+	3,  // 12: edgex.v2.Commands.DeviceCoreCommandsByDeviceName:output_type -> edgex.v2.DeviceCoreCommandResponse
+	11, // 13: edgex.v2.Commands.IssueGetCommandByName:output_type -> edgex.v2.EventResponse
+	7,  // 14: edgex.v2.Commands.IssueSetCommandByName:output_type -> edgex.v2.BaseResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_corecommand_proto_init() }
+func file_corecommand_proto_init() {
+	if File_corecommand_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_coredata_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_corecommand_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceCoreCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_corecommand_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoreCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_corecommand_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoreCommandParameter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_corecommand_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceCoreCommandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_corecommand_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiDeviceCoreCommandsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_corecommand_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCommandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_corecommand_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCommandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_corecommand_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_corecommand_proto_goTypes,
+		DependencyIndexes: file_corecommand_proto_depIdxs,
+		MessageInfos:      file_corecommand_proto_msgTypes,
+	}.Build()
+	File_corecommand_proto = out.File
+	file_corecommand_proto_rawDesc = nil
+	file_corecommand_proto_goTypes = nil
+	file_corecommand_proto_depIdxs = nil
+}
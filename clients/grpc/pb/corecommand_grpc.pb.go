@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: corecommand.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CommandsClient is the client API for Commands service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommandsClient interface {
+	AllDeviceCoreCommands(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*MultiDeviceCoreCommandsResponse, error)
+	DeviceCoreCommandsByDeviceName(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*DeviceCoreCommandResponse, error)
+	// IssueGetCommandByName answers with an EventResponse without base if the device service returns no event.
+	IssueGetCommandByName(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*EventResponse, error)
+	IssueSetCommandByName(ctx context.Context, in *SetCommandRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+}
+
+type commandsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommandsClient(cc grpc.ClientConnInterface) CommandsClient {
+	return &commandsClient{cc}
+}
+
+func (c *commandsClient) AllDeviceCoreCommands(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*MultiDeviceCoreCommandsResponse, error) {
+	out := new(MultiDeviceCoreCommandsResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Commands/AllDeviceCoreCommands", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandsClient) DeviceCoreCommandsByDeviceName(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*DeviceCoreCommandResponse, error) {
+	out := new(DeviceCoreCommandResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Commands/DeviceCoreCommandsByDeviceName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandsClient) IssueGetCommandByName(ctx context.Context, in *GetCommandRequest, opts ...grpc.CallOption) (*EventResponse, error) {
+	out := new(EventResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Commands/IssueGetCommandByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandsClient) IssueSetCommandByName(ctx context.Context, in *SetCommandRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Commands/IssueSetCommandByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommandsServer is the server API for Commands service.
+// All implementations must embed UnimplementedCommandsServer
+// for forward compatibility
+type CommandsServer interface {
+	AllDeviceCoreCommands(context.Context, *PageRequest) (*MultiDeviceCoreCommandsResponse, error)
+	DeviceCoreCommandsByDeviceName(context.Context, *NameRequest) (*DeviceCoreCommandResponse, error)
+	// IssueGetCommandByName answers with an EventResponse without base if the device service returns no event.
+	IssueGetCommandByName(context.Context, *GetCommandRequest) (*EventResponse, error)
+	IssueSetCommandByName(context.Context, *SetCommandRequest) (*BaseResponse, error)
+	mustEmbedUnimplementedCommandsServer()
+}
+
+// UnimplementedCommandsServer must be embedded to have forward compatible implementations.
+type UnimplementedCommandsServer struct {
+}
+
+func (UnimplementedCommandsServer) AllDeviceCoreCommands(context.Context, *PageRequest) (*MultiDeviceCoreCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDeviceCoreCommands not implemented")
+}
+func (UnimplementedCommandsServer) DeviceCoreCommandsByDeviceName(context.Context, *NameRequest) (*DeviceCoreCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceCoreCommandsByDeviceName not implemented")
+}
+func (UnimplementedCommandsServer) IssueGetCommandByName(context.Context, *GetCommandRequest) (*EventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueGetCommandByName not implemented")
+}
+func (UnimplementedCommandsServer) IssueSetCommandByName(context.Context, *SetCommandRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueSetCommandByName not implemented")
+}
+func (UnimplementedCommandsServer) mustEmbedUnimplementedCommandsServer() {}
+
+// UnsafeCommandsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommandsServer will
+// result in compilation errors.
+type UnsafeCommandsServer interface {
+	mustEmbedUnimplementedCommandsServer()
+}
+
+func RegisterCommandsServer(s grpc.ServiceRegistrar, srv CommandsServer) {
+	s.RegisterService(&Commands_ServiceDesc, srv)
+}
+
+func _Commands_AllDeviceCoreCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsServer).AllDeviceCoreCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Commands/AllDeviceCoreCommands",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsServer).AllDeviceCoreCommands(ctx, req.(*PageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Commands_DeviceCoreCommandsByDeviceName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsServer).DeviceCoreCommandsByDeviceName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Commands/DeviceCoreCommandsByDeviceName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsServer).DeviceCoreCommandsByDeviceName(ctx, req.(*NameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Commands_IssueGetCommandByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsServer).IssueGetCommandByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Commands/IssueGetCommandByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsServer).IssueGetCommandByName(ctx, req.(*GetCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Commands_IssueSetCommandByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsServer).IssueSetCommandByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Commands/IssueSetCommandByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsServer).IssueSetCommandByName(ctx, req.(*SetCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Commands_ServiceDesc is the grpc.ServiceDesc for Commands service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Commands_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "edgex.v2.Commands",
+	HandlerType: (*CommandsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AllDeviceCoreCommands",
+			Handler:    _Commands_AllDeviceCoreCommands_Handler,
+		},
+		{
+			MethodName: "DeviceCoreCommandsByDeviceName",
+			Handler:    _Commands_DeviceCoreCommandsByDeviceName_Handler,
+		},
+		{
+			MethodName: "IssueGetCommandByName",
+			Handler:    _Commands_IssueGetCommandByName_Handler,
+		},
+		{
+			MethodName: "IssueSetCommandByName",
+			Handler:    _Commands_IssueSetCommandByName_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "corecommand.proto",
+}
@@ -0,0 +1,841 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: coredata.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EventsClient is the client API for Events service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventsClient interface {
+	Add(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*BaseWithIdResponse, error)
+	// AddStream adds the events sent over a single stream, answering with the response of each event in the order the
+	// events are sent. A failed event doesn't end the stream, its response holds the status code of the failure.
+	AddStream(ctx context.Context, opts ...grpc.CallOption) (Events_AddStreamClient, error)
+	AllEvents(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*MultiEventsResponse, error)
+	EventCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CountResponse, error)
+	EventCountByDeviceName(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	EventsByDeviceName(ctx context.Context, in *NamePageRequest, opts ...grpc.CallOption) (*MultiEventsResponse, error)
+	DeleteByDeviceName(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	EventsByTimeRange(ctx context.Context, in *TimeRangeRequest, opts ...grpc.CallOption) (*MultiEventsResponse, error)
+	DeleteByAge(ctx context.Context, in *AgeRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+}
+
+type eventsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventsClient(cc grpc.ClientConnInterface) EventsClient {
+	return &eventsClient{cc}
+}
+
+func (c *eventsClient) Add(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*BaseWithIdResponse, error) {
+	out := new(BaseWithIdResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Events/Add", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsClient) AddStream(ctx context.Context, opts ...grpc.CallOption) (Events_AddStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Events_ServiceDesc.Streams[0], "/edgex.v2.Events/AddStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventsAddStreamClient{stream}
+	return x, nil
+}
+
+type Events_AddStreamClient interface {
+	Send(*AddEventRequest) error
+	Recv() (*BaseWithIdResponse, error)
+	grpc.ClientStream
+}
+
+type eventsAddStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventsAddStreamClient) Send(m *AddEventRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *eventsAddStreamClient) Recv() (*BaseWithIdResponse, error) {
+	m := new(BaseWithIdResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *eventsClient) AllEvents(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*MultiEventsResponse, error) {
+	out := new(MultiEventsResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Events/AllEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsClient) EventCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CountResponse, error) {
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Events/EventCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsClient) EventCountByDeviceName(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Events/EventCountByDeviceName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsClient) EventsByDeviceName(ctx context.Context, in *NamePageRequest, opts ...grpc.CallOption) (*MultiEventsResponse, error) {
+	out := new(MultiEventsResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Events/EventsByDeviceName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsClient) DeleteByDeviceName(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Events/DeleteByDeviceName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsClient) EventsByTimeRange(ctx context.Context, in *TimeRangeRequest, opts ...grpc.CallOption) (*MultiEventsResponse, error) {
+	out := new(MultiEventsResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Events/EventsByTimeRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventsClient) DeleteByAge(ctx context.Context, in *AgeRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Events/DeleteByAge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventsServer is the server API for Events service.
+// All implementations must embed UnimplementedEventsServer
+// for forward compatibility
+type EventsServer interface {
+	Add(context.Context, *AddEventRequest) (*BaseWithIdResponse, error)
+	// AddStream adds the events sent over a single stream, answering with the response of each event in the order the
+	// events are sent. A failed event doesn't end the stream, its response holds the status code of the failure.
+	AddStream(Events_AddStreamServer) error
+	AllEvents(context.Context, *PageRequest) (*MultiEventsResponse, error)
+	EventCount(context.Context, *emptypb.Empty) (*CountResponse, error)
+	EventCountByDeviceName(context.Context, *NameRequest) (*CountResponse, error)
+	EventsByDeviceName(context.Context, *NamePageRequest) (*MultiEventsResponse, error)
+	DeleteByDeviceName(context.Context, *NameRequest) (*BaseResponse, error)
+	EventsByTimeRange(context.Context, *TimeRangeRequest) (*MultiEventsResponse, error)
+	DeleteByAge(context.Context, *AgeRequest) (*BaseResponse, error)
+	mustEmbedUnimplementedEventsServer()
+}
+
+// UnimplementedEventsServer must be embedded to have forward compatible implementations.
+type UnimplementedEventsServer struct {
+}
+
+func (UnimplementedEventsServer) Add(context.Context, *AddEventRequest) (*BaseWithIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedEventsServer) AddStream(Events_AddStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method AddStream not implemented")
+}
+func (UnimplementedEventsServer) AllEvents(context.Context, *PageRequest) (*MultiEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllEvents not implemented")
+}
+func (UnimplementedEventsServer) EventCount(context.Context, *emptypb.Empty) (*CountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventCount not implemented")
+}
+func (UnimplementedEventsServer) EventCountByDeviceName(context.Context, *NameRequest) (*CountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventCountByDeviceName not implemented")
+}
+func (UnimplementedEventsServer) EventsByDeviceName(context.Context, *NamePageRequest) (*MultiEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventsByDeviceName not implemented")
+}
+func (UnimplementedEventsServer) DeleteByDeviceName(context.Context, *NameRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteByDeviceName not implemented")
+}
+func (UnimplementedEventsServer) EventsByTimeRange(context.Context, *TimeRangeRequest) (*MultiEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventsByTimeRange not implemented")
+}
+func (UnimplementedEventsServer) DeleteByAge(context.Context, *AgeRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteByAge not implemented")
+}
+func (UnimplementedEventsServer) mustEmbedUnimplementedEventsServer() {}
+
+// UnsafeEventsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventsServer will
+// result in compilation errors.
+type UnsafeEventsServer interface {
+	mustEmbedUnimplementedEventsServer()
+}
+
+func RegisterEventsServer(s grpc.ServiceRegistrar, srv EventsServer) {
+	s.RegisterService(&Events_ServiceDesc, srv)
+}
+
+func _Events_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Events/Add",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).Add(ctx, req.(*AddEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Events_AddStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EventsServer).AddStream(&eventsAddStreamServer{stream})
+}
+
+type Events_AddStreamServer interface {
+	Send(*BaseWithIdResponse) error
+	Recv() (*AddEventRequest, error)
+	grpc.ServerStream
+}
+
+type eventsAddStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventsAddStreamServer) Send(m *BaseWithIdResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *eventsAddStreamServer) Recv() (*AddEventRequest, error) {
+	m := new(AddEventRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Events_AllEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).AllEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Events/AllEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).AllEvents(ctx, req.(*PageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Events_EventCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).EventCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Events/EventCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).EventCount(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Events_EventCountByDeviceName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).EventCountByDeviceName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Events/EventCountByDeviceName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).EventCountByDeviceName(ctx, req.(*NameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Events_EventsByDeviceName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).EventsByDeviceName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Events/EventsByDeviceName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).EventsByDeviceName(ctx, req.(*NamePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Events_DeleteByDeviceName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).DeleteByDeviceName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Events/DeleteByDeviceName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).DeleteByDeviceName(ctx, req.(*NameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Events_EventsByTimeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).EventsByTimeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Events/EventsByTimeRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).EventsByTimeRange(ctx, req.(*TimeRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Events_DeleteByAge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).DeleteByAge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Events/DeleteByAge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).DeleteByAge(ctx, req.(*AgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Events_ServiceDesc is the grpc.ServiceDesc for Events service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Events_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "edgex.v2.Events",
+	HandlerType: (*EventsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Add",
+			Handler:    _Events_Add_Handler,
+		},
+		{
+			MethodName: "AllEvents",
+			Handler:    _Events_AllEvents_Handler,
+		},
+		{
+			MethodName: "EventCount",
+			Handler:    _Events_EventCount_Handler,
+		},
+		{
+			MethodName: "EventCountByDeviceName",
+			Handler:    _Events_EventCountByDeviceName_Handler,
+		},
+		{
+			MethodName: "EventsByDeviceName",
+			Handler:    _Events_EventsByDeviceName_Handler,
+		},
+		{
+			MethodName: "DeleteByDeviceName",
+			Handler:    _Events_DeleteByDeviceName_Handler,
+		},
+		{
+			MethodName: "EventsByTimeRange",
+			Handler:    _Events_EventsByTimeRange_Handler,
+		},
+		{
+			MethodName: "DeleteByAge",
+			Handler:    _Events_DeleteByAge_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AddStream",
+			Handler:       _Events_AddStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "coredata.proto",
+}
+
+// ReadingsClient is the client API for Readings service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReadingsClient interface {
+	AllReadings(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error)
+	ReadingCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CountResponse, error)
+	ReadingCountByDeviceName(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*CountResponse, error)
+	ReadingsByDeviceName(ctx context.Context, in *NamePageRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error)
+	ReadingsByResourceName(ctx context.Context, in *NamePageRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error)
+	ReadingsByTimeRange(ctx context.Context, in *TimeRangeRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error)
+	ReadingsByResourceNameAndTimeRange(ctx context.Context, in *NameTimeRangeRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error)
+	ReadingsByDeviceNameAndResourceName(ctx context.Context, in *ResourceNamePageRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error)
+	ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx context.Context, in *ResourceNameTimeRangeRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error)
+	ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx context.Context, in *ResourceNamesTimeRangeRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error)
+}
+
+type readingsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReadingsClient(cc grpc.ClientConnInterface) ReadingsClient {
+	return &readingsClient{cc}
+}
+
+func (c *readingsClient) AllReadings(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error) {
+	out := new(MultiReadingsResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Readings/AllReadings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingsClient) ReadingCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CountResponse, error) {
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Readings/ReadingCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingsClient) ReadingCountByDeviceName(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*CountResponse, error) {
+	out := new(CountResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Readings/ReadingCountByDeviceName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingsClient) ReadingsByDeviceName(ctx context.Context, in *NamePageRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error) {
+	out := new(MultiReadingsResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Readings/ReadingsByDeviceName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingsClient) ReadingsByResourceName(ctx context.Context, in *NamePageRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error) {
+	out := new(MultiReadingsResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Readings/ReadingsByResourceName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingsClient) ReadingsByTimeRange(ctx context.Context, in *TimeRangeRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error) {
+	out := new(MultiReadingsResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Readings/ReadingsByTimeRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingsClient) ReadingsByResourceNameAndTimeRange(ctx context.Context, in *NameTimeRangeRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error) {
+	out := new(MultiReadingsResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Readings/ReadingsByResourceNameAndTimeRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingsClient) ReadingsByDeviceNameAndResourceName(ctx context.Context, in *ResourceNamePageRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error) {
+	out := new(MultiReadingsResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Readings/ReadingsByDeviceNameAndResourceName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingsClient) ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx context.Context, in *ResourceNameTimeRangeRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error) {
+	out := new(MultiReadingsResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Readings/ReadingsByDeviceNameAndResourceNameAndTimeRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *readingsClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx context.Context, in *ResourceNamesTimeRangeRequest, opts ...grpc.CallOption) (*MultiReadingsResponse, error) {
+	out := new(MultiReadingsResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Readings/ReadingsByDeviceNameAndResourceNamesAndTimeRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReadingsServer is the server API for Readings service.
+// All implementations must embed UnimplementedReadingsServer
+// for forward compatibility
+type ReadingsServer interface {
+	AllReadings(context.Context, *PageRequest) (*MultiReadingsResponse, error)
+	ReadingCount(context.Context, *emptypb.Empty) (*CountResponse, error)
+	ReadingCountByDeviceName(context.Context, *NameRequest) (*CountResponse, error)
+	ReadingsByDeviceName(context.Context, *NamePageRequest) (*MultiReadingsResponse, error)
+	ReadingsByResourceName(context.Context, *NamePageRequest) (*MultiReadingsResponse, error)
+	ReadingsByTimeRange(context.Context, *TimeRangeRequest) (*MultiReadingsResponse, error)
+	ReadingsByResourceNameAndTimeRange(context.Context, *NameTimeRangeRequest) (*MultiReadingsResponse, error)
+	ReadingsByDeviceNameAndResourceName(context.Context, *ResourceNamePageRequest) (*MultiReadingsResponse, error)
+	ReadingsByDeviceNameAndResourceNameAndTimeRange(context.Context, *ResourceNameTimeRangeRequest) (*MultiReadingsResponse, error)
+	ReadingsByDeviceNameAndResourceNamesAndTimeRange(context.Context, *ResourceNamesTimeRangeRequest) (*MultiReadingsResponse, error)
+	mustEmbedUnimplementedReadingsServer()
+}
+
+// UnimplementedReadingsServer must be embedded to have forward compatible implementations.
+type UnimplementedReadingsServer struct {
+}
+
+func (UnimplementedReadingsServer) AllReadings(context.Context, *PageRequest) (*MultiReadingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllReadings not implemented")
+}
+func (UnimplementedReadingsServer) ReadingCount(context.Context, *emptypb.Empty) (*CountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadingCount not implemented")
+}
+func (UnimplementedReadingsServer) ReadingCountByDeviceName(context.Context, *NameRequest) (*CountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadingCountByDeviceName not implemented")
+}
+func (UnimplementedReadingsServer) ReadingsByDeviceName(context.Context, *NamePageRequest) (*MultiReadingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadingsByDeviceName not implemented")
+}
+func (UnimplementedReadingsServer) ReadingsByResourceName(context.Context, *NamePageRequest) (*MultiReadingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadingsByResourceName not implemented")
+}
+func (UnimplementedReadingsServer) ReadingsByTimeRange(context.Context, *TimeRangeRequest) (*MultiReadingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadingsByTimeRange not implemented")
+}
+func (UnimplementedReadingsServer) ReadingsByResourceNameAndTimeRange(context.Context, *NameTimeRangeRequest) (*MultiReadingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadingsByResourceNameAndTimeRange not implemented")
+}
+func (UnimplementedReadingsServer) ReadingsByDeviceNameAndResourceName(context.Context, *ResourceNamePageRequest) (*MultiReadingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadingsByDeviceNameAndResourceName not implemented")
+}
+func (UnimplementedReadingsServer) ReadingsByDeviceNameAndResourceNameAndTimeRange(context.Context, *ResourceNameTimeRangeRequest) (*MultiReadingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadingsByDeviceNameAndResourceNameAndTimeRange not implemented")
+}
+func (UnimplementedReadingsServer) ReadingsByDeviceNameAndResourceNamesAndTimeRange(context.Context, *ResourceNamesTimeRangeRequest) (*MultiReadingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadingsByDeviceNameAndResourceNamesAndTimeRange not implemented")
+}
+func (UnimplementedReadingsServer) mustEmbedUnimplementedReadingsServer() {}
+
+// UnsafeReadingsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReadingsServer will
+// result in compilation errors.
+type UnsafeReadingsServer interface {
+	mustEmbedUnimplementedReadingsServer()
+}
+
+func RegisterReadingsServer(s grpc.ServiceRegistrar, srv ReadingsServer) {
+	s.RegisterService(&Readings_ServiceDesc, srv)
+}
+
+func _Readings_AllReadings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingsServer).AllReadings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Readings/AllReadings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingsServer).AllReadings(ctx, req.(*PageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Readings_ReadingCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingsServer).ReadingCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Readings/ReadingCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingsServer).ReadingCount(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Readings_ReadingCountByDeviceName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingsServer).ReadingCountByDeviceName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Readings/ReadingCountByDeviceName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingsServer).ReadingCountByDeviceName(ctx, req.(*NameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Readings_ReadingsByDeviceName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingsServer).ReadingsByDeviceName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Readings/ReadingsByDeviceName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingsServer).ReadingsByDeviceName(ctx, req.(*NamePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Readings_ReadingsByResourceName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingsServer).ReadingsByResourceName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Readings/ReadingsByResourceName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingsServer).ReadingsByResourceName(ctx, req.(*NamePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Readings_ReadingsByTimeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingsServer).ReadingsByTimeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Readings/ReadingsByTimeRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingsServer).ReadingsByTimeRange(ctx, req.(*TimeRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Readings_ReadingsByResourceNameAndTimeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NameTimeRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingsServer).ReadingsByResourceNameAndTimeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Readings/ReadingsByResourceNameAndTimeRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingsServer).ReadingsByResourceNameAndTimeRange(ctx, req.(*NameTimeRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Readings_ReadingsByDeviceNameAndResourceName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceNamePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingsServer).ReadingsByDeviceNameAndResourceName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Readings/ReadingsByDeviceNameAndResourceName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingsServer).ReadingsByDeviceNameAndResourceName(ctx, req.(*ResourceNamePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Readings_ReadingsByDeviceNameAndResourceNameAndTimeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceNameTimeRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingsServer).ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Readings/ReadingsByDeviceNameAndResourceNameAndTimeRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingsServer).ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx, req.(*ResourceNameTimeRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Readings_ReadingsByDeviceNameAndResourceNamesAndTimeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceNamesTimeRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadingsServer).ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Readings/ReadingsByDeviceNameAndResourceNamesAndTimeRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadingsServer).ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx, req.(*ResourceNamesTimeRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Readings_ServiceDesc is the grpc.ServiceDesc for Readings service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Readings_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "edgex.v2.Readings",
+	HandlerType: (*ReadingsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AllReadings",
+			Handler:    _Readings_AllReadings_Handler,
+		},
+		{
+			MethodName: "ReadingCount",
+			Handler:    _Readings_ReadingCount_Handler,
+		},
+		{
+			MethodName: "ReadingCountByDeviceName",
+			Handler:    _Readings_ReadingCountByDeviceName_Handler,
+		},
+		{
+			MethodName: "ReadingsByDeviceName",
+			Handler:    _Readings_ReadingsByDeviceName_Handler,
+		},
+		{
+			MethodName: "ReadingsByResourceName",
+			Handler:    _Readings_ReadingsByResourceName_Handler,
+		},
+		{
+			MethodName: "ReadingsByTimeRange",
+			Handler:    _Readings_ReadingsByTimeRange_Handler,
+		},
+		{
+			MethodName: "ReadingsByResourceNameAndTimeRange",
+			Handler:    _Readings_ReadingsByResourceNameAndTimeRange_Handler,
+		},
+		{
+			MethodName: "ReadingsByDeviceNameAndResourceName",
+			Handler:    _Readings_ReadingsByDeviceNameAndResourceName_Handler,
+		},
+		{
+			MethodName: "ReadingsByDeviceNameAndResourceNameAndTimeRange",
+			Handler:    _Readings_ReadingsByDeviceNameAndResourceNameAndTimeRange_Handler,
+		},
+		{
+			MethodName: "ReadingsByDeviceNameAndResourceNamesAndTimeRange",
+			Handler:    _Readings_ReadingsByDeviceNameAndResourceNamesAndTimeRange_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coredata.proto",
+}
@@ -0,0 +1,361 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: coremetadata.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// DevicesClient is the client API for Devices service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DevicesClient interface {
+	// Add adds the devices, answering with the response of each device in the order of the requests.
+	Add(ctx context.Context, in *AddDevicesRequest, opts ...grpc.CallOption) (*MultiBaseWithIdResponse, error)
+	// Update updates the devices, answering with the response of each device in the order of the requests.
+	Update(ctx context.Context, in *UpdateDevicesRequest, opts ...grpc.CallOption) (*MultiBaseResponse, error)
+	AllDevices(ctx context.Context, in *LabelsPageRequest, opts ...grpc.CallOption) (*MultiDevicesResponse, error)
+	DeviceNameExists(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	DeviceByName(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*DeviceResponse, error)
+	DeleteDeviceByName(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	DevicesByProfileName(ctx context.Context, in *NamePageRequest, opts ...grpc.CallOption) (*MultiDevicesResponse, error)
+	DevicesByServiceName(ctx context.Context, in *NamePageRequest, opts ...grpc.CallOption) (*MultiDevicesResponse, error)
+}
+
+type devicesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDevicesClient(cc grpc.ClientConnInterface) DevicesClient {
+	return &devicesClient{cc}
+}
+
+func (c *devicesClient) Add(ctx context.Context, in *AddDevicesRequest, opts ...grpc.CallOption) (*MultiBaseWithIdResponse, error) {
+	out := new(MultiBaseWithIdResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Devices/Add", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devicesClient) Update(ctx context.Context, in *UpdateDevicesRequest, opts ...grpc.CallOption) (*MultiBaseResponse, error) {
+	out := new(MultiBaseResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Devices/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devicesClient) AllDevices(ctx context.Context, in *LabelsPageRequest, opts ...grpc.CallOption) (*MultiDevicesResponse, error) {
+	out := new(MultiDevicesResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Devices/AllDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devicesClient) DeviceNameExists(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Devices/DeviceNameExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devicesClient) DeviceByName(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*DeviceResponse, error) {
+	out := new(DeviceResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Devices/DeviceByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devicesClient) DeleteDeviceByName(ctx context.Context, in *NameRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Devices/DeleteDeviceByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devicesClient) DevicesByProfileName(ctx context.Context, in *NamePageRequest, opts ...grpc.CallOption) (*MultiDevicesResponse, error) {
+	out := new(MultiDevicesResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Devices/DevicesByProfileName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *devicesClient) DevicesByServiceName(ctx context.Context, in *NamePageRequest, opts ...grpc.CallOption) (*MultiDevicesResponse, error) {
+	out := new(MultiDevicesResponse)
+	err := c.cc.Invoke(ctx, "/edgex.v2.Devices/DevicesByServiceName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DevicesServer is the server API for Devices service.
+// All implementations must embed UnimplementedDevicesServer
+// for forward compatibility
+type DevicesServer interface {
+	// Add adds the devices, answering with the response of each device in the order of the requests.
+	Add(context.Context, *AddDevicesRequest) (*MultiBaseWithIdResponse, error)
+	// Update updates the devices, answering with the response of each device in the order of the requests.
+	Update(context.Context, *UpdateDevicesRequest) (*MultiBaseResponse, error)
+	AllDevices(context.Context, *LabelsPageRequest) (*MultiDevicesResponse, error)
+	DeviceNameExists(context.Context, *NameRequest) (*BaseResponse, error)
+	DeviceByName(context.Context, *NameRequest) (*DeviceResponse, error)
+	DeleteDeviceByName(context.Context, *NameRequest) (*BaseResponse, error)
+	DevicesByProfileName(context.Context, *NamePageRequest) (*MultiDevicesResponse, error)
+	DevicesByServiceName(context.Context, *NamePageRequest) (*MultiDevicesResponse, error)
+	mustEmbedUnimplementedDevicesServer()
+}
+
+// UnimplementedDevicesServer must be embedded to have forward compatible implementations.
+type UnimplementedDevicesServer struct {
+}
+
+func (UnimplementedDevicesServer) Add(context.Context, *AddDevicesRequest) (*MultiBaseWithIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
+}
+func (UnimplementedDevicesServer) Update(context.Context, *UpdateDevicesRequest) (*MultiBaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedDevicesServer) AllDevices(context.Context, *LabelsPageRequest) (*MultiDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllDevices not implemented")
+}
+func (UnimplementedDevicesServer) DeviceNameExists(context.Context, *NameRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceNameExists not implemented")
+}
+func (UnimplementedDevicesServer) DeviceByName(context.Context, *NameRequest) (*DeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceByName not implemented")
+}
+func (UnimplementedDevicesServer) DeleteDeviceByName(context.Context, *NameRequest) (*BaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeviceByName not implemented")
+}
+func (UnimplementedDevicesServer) DevicesByProfileName(context.Context, *NamePageRequest) (*MultiDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DevicesByProfileName not implemented")
+}
+func (UnimplementedDevicesServer) DevicesByServiceName(context.Context, *NamePageRequest) (*MultiDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DevicesByServiceName not implemented")
+}
+func (UnimplementedDevicesServer) mustEmbedUnimplementedDevicesServer() {}
+
+// UnsafeDevicesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DevicesServer will
+// result in compilation errors.
+type UnsafeDevicesServer interface {
+	mustEmbedUnimplementedDevicesServer()
+}
+
+func RegisterDevicesServer(s grpc.ServiceRegistrar, srv DevicesServer) {
+	s.RegisterService(&Devices_ServiceDesc, srv)
+}
+
+func _Devices_Add_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevicesServer).Add(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Devices/Add",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevicesServer).Add(ctx, req.(*AddDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Devices_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevicesServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Devices/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevicesServer).Update(ctx, req.(*UpdateDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Devices_AllDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelsPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevicesServer).AllDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Devices/AllDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevicesServer).AllDevices(ctx, req.(*LabelsPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Devices_DeviceNameExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevicesServer).DeviceNameExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Devices/DeviceNameExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevicesServer).DeviceNameExists(ctx, req.(*NameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Devices_DeviceByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevicesServer).DeviceByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Devices/DeviceByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevicesServer).DeviceByName(ctx, req.(*NameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Devices_DeleteDeviceByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevicesServer).DeleteDeviceByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Devices/DeleteDeviceByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevicesServer).DeleteDeviceByName(ctx, req.(*NameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Devices_DevicesByProfileName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevicesServer).DevicesByProfileName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Devices/DevicesByProfileName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevicesServer).DevicesByProfileName(ctx, req.(*NamePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Devices_DevicesByServiceName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamePageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevicesServer).DevicesByServiceName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgex.v2.Devices/DevicesByServiceName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevicesServer).DevicesByServiceName(ctx, req.(*NamePageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Devices_ServiceDesc is the grpc.ServiceDesc for Devices service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Devices_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "edgex.v2.Devices",
+	HandlerType: (*DevicesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Add",
+			Handler:    _Devices_Add_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Devices_Update_Handler,
+		},
+		{
+			MethodName: "AllDevices",
+			Handler:    _Devices_AllDevices_Handler,
+		},
+		{
+			MethodName: "DeviceNameExists",
+			Handler:    _Devices_DeviceNameExists_Handler,
+		},
+		{
+			MethodName: "DeviceByName",
+			Handler:    _Devices_DeviceByName_Handler,
+		},
+		{
+			MethodName: "DeleteDeviceByName",
+			Handler:    _Devices_DeleteDeviceByName_Handler,
+		},
+		{
+			MethodName: "DevicesByProfileName",
+			Handler:    _Devices_DevicesByProfileName_Handler,
+		},
+		{
+			MethodName: "DevicesByServiceName",
+			Handler:    _Devices_DevicesByServiceName_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coremetadata.proto",
+}
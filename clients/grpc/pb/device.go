@@ -0,0 +1,262 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// FromDeviceDTO converts the Device DTO to its protobuf message
+func FromDeviceDTO(dto dtos.Device) (*Device, errors.EdgeX) {
+	location, err := toValue(dto.Location)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to convert the device location", err)
+	}
+	return &Device{
+		Created:        dto.Created,
+		Modified:       dto.Modified,
+		Id:             dto.Id,
+		Name:           dto.Name,
+		Description:    dto.Description,
+		AdminState:     dto.AdminState,
+		OperatingState: dto.OperatingState,
+		LastConnected:  dto.LastConnected,
+		LastReported:   dto.LastReported,
+		Labels:         dto.Labels,
+		Location:       location,
+		ServiceName:    dto.ServiceName,
+		ProfileName:    dto.ProfileName,
+		AutoEvents:     fromAutoEventDTOs(dto.AutoEvents),
+		Protocols:      fromProtocolsDTO(dto.Protocols),
+	}, nil
+}
+
+// ToDeviceDTO converts the Device protobuf message to its DTO
+func ToDeviceDTO(msg *Device) dtos.Device {
+	return dtos.Device{
+		DBTimestamp:    dtos.DBTimestamp{Created: msg.GetCreated(), Modified: msg.GetModified()},
+		Id:             msg.GetId(),
+		Name:           msg.GetName(),
+		Description:    msg.GetDescription(),
+		AdminState:     msg.GetAdminState(),
+		OperatingState: msg.GetOperatingState(),
+		LastConnected:  msg.GetLastConnected(),
+		LastReported:   msg.GetLastReported(),
+		Labels:         msg.GetLabels(),
+		Location:       fromValue(msg.GetLocation()),
+		ServiceName:    msg.GetServiceName(),
+		ProfileName:    msg.GetProfileName(),
+		AutoEvents:     toAutoEventDTOs(msg.GetAutoEvents()),
+		Protocols:      toProtocolsDTO(msg.GetProtocols()),
+	}
+}
+
+// FromDeviceDTOs converts the Device DTOs to their protobuf messages
+func FromDeviceDTOs(devices []dtos.Device) ([]*Device, errors.EdgeX) {
+	msgs := make([]*Device, len(devices))
+	for i, dto := range devices {
+		msg, err := FromDeviceDTO(dto)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+// ToDeviceDTOs converts the Device protobuf messages to their DTOs
+func ToDeviceDTOs(msgs []*Device) []dtos.Device {
+	devices := make([]dtos.Device, len(msgs))
+	for i, msg := range msgs {
+		devices[i] = ToDeviceDTO(msg)
+	}
+	return devices
+}
+
+// FromDeviceModel converts the Device model to its protobuf message
+func FromDeviceModel(model models.Device) (*Device, errors.EdgeX) {
+	return FromDeviceDTO(dtos.FromDeviceModelToDTO(model))
+}
+
+// ToDeviceModel converts the Device protobuf message to its model
+func ToDeviceModel(msg *Device) models.Device {
+	model := dtos.ToDeviceModel(ToDeviceDTO(msg))
+	model.Created = msg.GetCreated()
+	model.Modified = msg.GetModified()
+	return model
+}
+
+// FromUpdateDeviceDTO converts the UpdateDevice DTO to its protobuf message, the nil fields of the DTO being left unset
+func FromUpdateDeviceDTO(dto dtos.UpdateDevice) (*UpdateDevice, errors.EdgeX) {
+	location, err := toValue(dto.Location)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to convert the device location", err)
+	}
+	msg := &UpdateDevice{
+		Id:             dto.Id,
+		Name:           dto.Name,
+		Description:    dto.Description,
+		AdminState:     dto.AdminState,
+		OperatingState: dto.OperatingState,
+		LastConnected:  dto.LastConnected,
+		LastReported:   dto.LastReported,
+		ServiceName:    dto.ServiceName,
+		ProfileName:    dto.ProfileName,
+		Location:       location,
+		Notify:         dto.Notify,
+	}
+	if dto.Labels != nil {
+		msg.Labels = &StringList{Values: dto.Labels}
+	}
+	if dto.AutoEvents != nil {
+		msg.AutoEvents = &AutoEventList{AutoEvents: fromAutoEventDTOs(dto.AutoEvents)}
+	}
+	if dto.Protocols != nil {
+		msg.Protocols = &ProtocolsMap{Protocols: fromProtocolsDTO(dto.Protocols)}
+	}
+	return msg, nil
+}
+
+// ToUpdateDeviceDTO converts the UpdateDevice protobuf message to its DTO, the fields which are not set being nil
+func ToUpdateDeviceDTO(msg *UpdateDevice) dtos.UpdateDevice {
+	dto := dtos.UpdateDevice{
+		Id:             msg.Id,
+		Name:           msg.Name,
+		Description:    msg.Description,
+		AdminState:     msg.AdminState,
+		OperatingState: msg.OperatingState,
+		LastConnected:  msg.LastConnected,
+		LastReported:   msg.LastReported,
+		ServiceName:    msg.ServiceName,
+		ProfileName:    msg.ProfileName,
+		Location:       fromValue(msg.GetLocation()),
+		Notify:         msg.Notify,
+	}
+	if msg.GetLabels() != nil {
+		dto.Labels = append([]string{}, msg.GetLabels().GetValues()...)
+	}
+	if msg.GetAutoEvents() != nil {
+		dto.AutoEvents = append([]dtos.AutoEvent{}, toAutoEventDTOs(msg.GetAutoEvents().GetAutoEvents())...)
+	}
+	if msg.GetProtocols() != nil {
+		dto.Protocols = toProtocolsDTO(msg.GetProtocols().GetProtocols())
+		if dto.Protocols == nil {
+			dto.Protocols = make(map[string]dtos.ProtocolProperties)
+		}
+	}
+	return dto
+}
+
+func fromAutoEventDTOs(autoEvents []dtos.AutoEvent) []*AutoEvent {
+	if autoEvents == nil {
+		return nil
+	}
+	msgs := make([]*AutoEvent, len(autoEvents))
+	for i, dto := range autoEvents {
+		msgs[i] = &AutoEvent{Interval: dto.Interval, OnChange: dto.OnChange, SourceName: dto.SourceName}
+	}
+	return msgs
+}
+
+func toAutoEventDTOs(msgs []*AutoEvent) []dtos.AutoEvent {
+	if msgs == nil {
+		return nil
+	}
+	autoEvents := make([]dtos.AutoEvent, len(msgs))
+	for i, msg := range msgs {
+		autoEvents[i] = dtos.AutoEvent{Interval: msg.GetInterval(), OnChange: msg.GetOnChange(), SourceName: msg.GetSourceName()}
+	}
+	return autoEvents
+}
+
+func fromProtocolsDTO(protocols map[string]dtos.ProtocolProperties) map[string]*ProtocolProperties {
+	if protocols == nil {
+		return nil
+	}
+	msgs := make(map[string]*ProtocolProperties, len(protocols))
+	for name, properties := range protocols {
+		msgs[name] = &ProtocolProperties{Properties: properties}
+	}
+	return msgs
+}
+
+func toProtocolsDTO(msgs map[string]*ProtocolProperties) map[string]dtos.ProtocolProperties {
+	if msgs == nil {
+		return nil
+	}
+	protocols := make(map[string]dtos.ProtocolProperties, len(msgs))
+	for name, msg := range msgs {
+		protocols[name] = msg.GetProperties()
+	}
+	return protocols
+}
+
+// FromAddDeviceRequestDTO converts the AddDeviceRequest DTO to its protobuf message
+func FromAddDeviceRequestDTO(dto requests.AddDeviceRequest) (*AddDeviceRequest, errors.EdgeX) {
+	device, err := FromDeviceDTO(dto.Device)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return &AddDeviceRequest{Base: FromBaseRequestDTO(dto.BaseRequest), Device: device}, nil
+}
+
+// ToAddDeviceRequestDTO converts the AddDeviceRequest protobuf message to its DTO
+func ToAddDeviceRequestDTO(msg *AddDeviceRequest) requests.AddDeviceRequest {
+	return requests.AddDeviceRequest{BaseRequest: ToBaseRequestDTO(msg.GetBase()), Device: ToDeviceDTO(msg.GetDevice())}
+}
+
+// FromUpdateDeviceRequestDTO converts the UpdateDeviceRequest DTO to its protobuf message
+func FromUpdateDeviceRequestDTO(dto requests.UpdateDeviceRequest) (*UpdateDeviceRequest, errors.EdgeX) {
+	device, err := FromUpdateDeviceDTO(dto.Device)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return &UpdateDeviceRequest{Base: FromBaseRequestDTO(dto.BaseRequest), Device: device}, nil
+}
+
+// ToUpdateDeviceRequestDTO converts the UpdateDeviceRequest protobuf message to its DTO
+func ToUpdateDeviceRequestDTO(msg *UpdateDeviceRequest) requests.UpdateDeviceRequest {
+	return requests.UpdateDeviceRequest{BaseRequest: ToBaseRequestDTO(msg.GetBase()), Device: ToUpdateDeviceDTO(msg.GetDevice())}
+}
+
+// FromDeviceResponseDTO converts the DeviceResponse DTO to its protobuf message
+func FromDeviceResponseDTO(dto responses.DeviceResponse) (*DeviceResponse, errors.EdgeX) {
+	device, err := FromDeviceDTO(dto.Device)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return &DeviceResponse{Base: FromBaseResponseDTO(dto.BaseResponse), Device: device}, nil
+}
+
+// ToDeviceResponseDTO converts the DeviceResponse protobuf message to its DTO
+func ToDeviceResponseDTO(msg *DeviceResponse) responses.DeviceResponse {
+	return responses.DeviceResponse{BaseResponse: ToBaseResponseDTO(msg.GetBase()), Device: ToDeviceDTO(msg.GetDevice())}
+}
+
+// FromMultiDevicesResponseDTO converts the MultiDevicesResponse DTO to its protobuf message
+func FromMultiDevicesResponseDTO(dto responses.MultiDevicesResponse) (*MultiDevicesResponse, errors.EdgeX) {
+	devices, err := FromDeviceDTOs(dto.Devices)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return &MultiDevicesResponse{Base: FromBaseResponseDTO(dto.BaseResponse), TotalCount: dto.TotalCount, Devices: devices}, nil
+}
+
+// ToMultiDevicesResponseDTO converts the MultiDevicesResponse protobuf message to its DTO
+func ToMultiDevicesResponseDTO(msg *MultiDevicesResponse) responses.MultiDevicesResponse {
+	return responses.MultiDevicesResponse{
+		BaseWithTotalCountResponse: dtoCommon.BaseWithTotalCountResponse{
+			BaseResponse: ToBaseResponseDTO(msg.GetBase()),
+			TotalCount:   msg.GetTotalCount(),
+		},
+		Devices: ToDeviceDTOs(msg.GetDevices()),
+	}
+}
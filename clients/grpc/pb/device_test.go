@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestDevice() dtos.Device {
+	return dtos.Device{
+		DBTimestamp:    dtos.DBTimestamp{Created: 100, Modified: 200},
+		Id:             "4a5f3c4d-6a8b-4e6b-9a55-1c7a9d6b1f20",
+		Name:           "device1",
+		Description:    "test device",
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		LastConnected:  300,
+		LastReported:   400,
+		Labels:         []string{"label1", "label2"},
+		Location:       map[string]interface{}{"building": "A", "floor": float64(2)},
+		ServiceName:    "service1",
+		ProfileName:    "profile1",
+		AutoEvents:     []dtos.AutoEvent{{Interval: "10s", OnChange: true, SourceName: "temperature"}},
+		Protocols:      map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": "localhost", "Port": "502"}},
+	}
+}
+
+func TestDeviceRoundTrip(t *testing.T) {
+	device := newTestDevice()
+	msg, err := FromDeviceDTO(device)
+	require.NoError(t, err)
+
+	data, marshalErr := proto.Marshal(msg)
+	require.NoError(t, marshalErr)
+	decoded := &Device{}
+	require.NoError(t, proto.Unmarshal(data, decoded))
+	assert.Equal(t, device, ToDeviceDTO(decoded))
+}
+
+func TestDeviceModel(t *testing.T) {
+	model := dtos.ToDeviceModel(newTestDevice())
+	model.Created = 100
+	model.Modified = 200
+
+	msg, err := FromDeviceModel(model)
+	require.NoError(t, err)
+	assert.Equal(t, model, ToDeviceModel(msg), "the timestamps of the model are kept")
+}
+
+func TestUpdateDeviceRoundTrip(t *testing.T) {
+	name, locked, notify := "device1", models.Locked, false
+	tests := []struct {
+		name   string
+		update dtos.UpdateDevice
+	}{
+		{"unset fields", dtos.UpdateDevice{Name: &name}},
+		{"set fields", dtos.UpdateDevice{
+			Name:       &name,
+			AdminState: &locked,
+			Labels:     []string{"label1"},
+			Location:   "room 1",
+			AutoEvents: []dtos.AutoEvent{{Interval: "1m", SourceName: "humidity"}},
+			Protocols:  map[string]dtos.ProtocolProperties{"other": {"Address": "remote"}},
+			Notify:     &notify,
+		}},
+		{"cleared fields", dtos.UpdateDevice{
+			Name:       &name,
+			Labels:     []string{},
+			AutoEvents: []dtos.AutoEvent{},
+			Protocols:  map[string]dtos.ProtocolProperties{},
+		}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			msg, err := FromUpdateDeviceDTO(testCase.update)
+			require.NoError(t, err)
+
+			data, marshalErr := proto.Marshal(msg)
+			require.NoError(t, marshalErr)
+			decoded := &UpdateDevice{}
+			require.NoError(t, proto.Unmarshal(data, decoded))
+			assert.Equal(t, testCase.update, ToUpdateDeviceDTO(decoded), "nil and empty fields are told apart")
+		})
+	}
+}
@@ -0,0 +1,151 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// FromDeviceProfileDTO converts the DeviceProfile DTO to its protobuf message
+func FromDeviceProfileDTO(dto dtos.DeviceProfile) (*DeviceProfile, errors.EdgeX) {
+	resources := make([]*DeviceResource, len(dto.DeviceResources))
+	for i, resource := range dto.DeviceResources {
+		attributes, err := FromMap(resource.Attributes)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to convert the attributes of the device resource %s", resource.Name), err)
+		}
+		resources[i] = &DeviceResource{
+			Description: resource.Description,
+			Name:        resource.Name,
+			IsHidden:    resource.IsHidden,
+			Tag:         resource.Tag,
+			Properties:  fromResourcePropertiesDTO(resource.Properties),
+			Attributes:  attributes,
+		}
+	}
+	commands := make([]*DeviceCommand, len(dto.DeviceCommands))
+	for i, command := range dto.DeviceCommands {
+		operations := make([]*ResourceOperation, len(command.ResourceOperations))
+		for j, operation := range command.ResourceOperations {
+			operations[j] = &ResourceOperation{
+				DeviceResource: operation.DeviceResource,
+				DefaultValue:   operation.DefaultValue,
+				Mappings:       operation.Mappings,
+			}
+		}
+		commands[i] = &DeviceCommand{
+			Name:               command.Name,
+			IsHidden:           command.IsHidden,
+			ReadWrite:          command.ReadWrite,
+			ResourceOperations: operations,
+		}
+	}
+	return &DeviceProfile{
+		Created:         dto.Created,
+		Modified:        dto.Modified,
+		Id:              dto.Id,
+		Name:            dto.Name,
+		Manufacturer:    dto.Manufacturer,
+		Description:     dto.Description,
+		Model:           dto.Model,
+		Labels:          dto.Labels,
+		DeviceResources: resources,
+		DeviceCommands:  commands,
+	}, nil
+}
+
+// ToDeviceProfileDTO converts the DeviceProfile protobuf message to its DTO
+func ToDeviceProfileDTO(msg *DeviceProfile) dtos.DeviceProfile {
+	resources := make([]dtos.DeviceResource, len(msg.GetDeviceResources()))
+	for i, resource := range msg.GetDeviceResources() {
+		resources[i] = dtos.DeviceResource{
+			Description: resource.GetDescription(),
+			Name:        resource.GetName(),
+			IsHidden:    resource.GetIsHidden(),
+			Tag:         resource.GetTag(),
+			Properties:  toResourcePropertiesDTO(resource.GetProperties()),
+			Attributes:  ToMap(resource.GetAttributes()),
+		}
+	}
+	commands := make([]dtos.DeviceCommand, len(msg.GetDeviceCommands()))
+	for i, command := range msg.GetDeviceCommands() {
+		operations := make([]dtos.ResourceOperation, len(command.GetResourceOperations()))
+		for j, operation := range command.GetResourceOperations() {
+			operations[j] = dtos.ResourceOperation{
+				DeviceResource: operation.GetDeviceResource(),
+				DefaultValue:   operation.GetDefaultValue(),
+				Mappings:       operation.GetMappings(),
+			}
+		}
+		commands[i] = dtos.DeviceCommand{
+			Name:               command.GetName(),
+			IsHidden:           command.GetIsHidden(),
+			ReadWrite:          command.GetReadWrite(),
+			ResourceOperations: operations,
+		}
+	}
+	return dtos.DeviceProfile{
+		DBTimestamp:     dtos.DBTimestamp{Created: msg.GetCreated(), Modified: msg.GetModified()},
+		Id:              msg.GetId(),
+		Name:            msg.GetName(),
+		Manufacturer:    msg.GetManufacturer(),
+		Description:     msg.GetDescription(),
+		Model:           msg.GetModel(),
+		Labels:          msg.GetLabels(),
+		DeviceResources: resources,
+		DeviceCommands:  commands,
+	}
+}
+
+// FromDeviceProfileModel converts the DeviceProfile model to its protobuf message
+func FromDeviceProfileModel(model models.DeviceProfile) (*DeviceProfile, errors.EdgeX) {
+	return FromDeviceProfileDTO(dtos.FromDeviceProfileModelToDTO(model))
+}
+
+// ToDeviceProfileModel converts the DeviceProfile protobuf message to its model
+func ToDeviceProfileModel(msg *DeviceProfile) models.DeviceProfile {
+	return dtos.ToDeviceProfileModel(ToDeviceProfileDTO(msg))
+}
+
+func fromResourcePropertiesDTO(dto dtos.ResourceProperties) *ResourceProperties {
+	return &ResourceProperties{
+		ValueType:    dto.ValueType,
+		ReadWrite:    dto.ReadWrite,
+		Units:        dto.Units,
+		Minimum:      dto.Minimum,
+		Maximum:      dto.Maximum,
+		DefaultValue: dto.DefaultValue,
+		Mask:         dto.Mask,
+		Shift:        dto.Shift,
+		Scale:        dto.Scale,
+		Offset:       dto.Offset,
+		Base:         dto.Base,
+		Assertion:    dto.Assertion,
+		MediaType:    dto.MediaType,
+	}
+}
+
+func toResourcePropertiesDTO(msg *ResourceProperties) dtos.ResourceProperties {
+	return dtos.ResourceProperties{
+		ValueType:    msg.GetValueType(),
+		ReadWrite:    msg.GetReadWrite(),
+		Units:        msg.GetUnits(),
+		Minimum:      msg.GetMinimum(),
+		Maximum:      msg.GetMaximum(),
+		DefaultValue: msg.GetDefaultValue(),
+		Mask:         msg.GetMask(),
+		Shift:        msg.GetShift(),
+		Scale:        msg.GetScale(),
+		Offset:       msg.GetOffset(),
+		Base:         msg.GetBase(),
+		Assertion:    msg.GetAssertion(),
+		MediaType:    msg.GetMediaType(),
+	}
+}
@@ -0,0 +1,57 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceProfileRoundTrip(t *testing.T) {
+	profile := dtos.DeviceProfile{
+		Id:           "4a5f3c4d-6a8b-4e6b-9a55-1c7a9d6b1f21",
+		Name:         "profile1",
+		Manufacturer: "IOTech",
+		Description:  "test profile",
+		Model:        "model1",
+		Labels:       []string{"label1"},
+		DeviceResources: []dtos.DeviceResource{{
+			Description: "temperature in degrees Celsius",
+			Name:        "temperature",
+			Tag:         "sensor",
+			Properties: dtos.ResourceProperties{
+				ValueType: common.ValueTypeFloat32,
+				ReadWrite: common.ReadWrite_RW,
+				Units:     "C",
+				Minimum:   "-40",
+				Maximum:   "85",
+				Scale:     "0.1",
+			},
+			Attributes: map[string]interface{}{"register": float64(40001), "function": "holding"},
+		}},
+		DeviceCommands: []dtos.DeviceCommand{{
+			Name:      "state",
+			ReadWrite: common.ReadWrite_R,
+			ResourceOperations: []dtos.ResourceOperation{
+				{DeviceResource: "temperature", DefaultValue: "0", Mappings: map[string]string{"0": "off"}},
+			},
+		}},
+	}
+
+	msg, err := FromDeviceProfileDTO(profile)
+	require.NoError(t, err)
+	assert.Equal(t, profile, ToDeviceProfileDTO(msg))
+
+	model := ToDeviceProfileModel(msg)
+	fromModel, err := FromDeviceProfileModel(model)
+	require.NoError(t, err)
+	assert.Equal(t, profile, ToDeviceProfileDTO(fromModel))
+}
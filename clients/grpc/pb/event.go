@@ -0,0 +1,144 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// FromEventDTO converts the Event DTO to its protobuf message
+func FromEventDTO(dto dtos.Event) (*Event, errors.EdgeX) {
+	tags, err := FromMap(dto.Tags)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to convert the event tags", err)
+	}
+	readings, err := FromReadingDTOs(dto.Readings)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return &Event{
+		ApiVersion:  dto.ApiVersion,
+		Id:          dto.Id,
+		DeviceName:  dto.DeviceName,
+		ProfileName: dto.ProfileName,
+		SourceName:  dto.SourceName,
+		Origin:      dto.Origin,
+		Readings:    readings,
+		Tags:        tags,
+	}, nil
+}
+
+// ToEventDTO converts the Event protobuf message to its DTO
+func ToEventDTO(msg *Event) dtos.Event {
+	return dtos.Event{
+		Versionable: dtoCommon.Versionable{ApiVersion: msg.GetApiVersion()},
+		Id:          msg.GetId(),
+		DeviceName:  msg.GetDeviceName(),
+		ProfileName: msg.GetProfileName(),
+		SourceName:  msg.GetSourceName(),
+		Origin:      msg.GetOrigin(),
+		Readings:    ToReadingDTOs(msg.GetReadings()),
+		Tags:        ToMap(msg.GetTags()),
+	}
+}
+
+// FromEventDTOs converts the Event DTOs to their protobuf messages
+func FromEventDTOs(events []dtos.Event) ([]*Event, errors.EdgeX) {
+	msgs := make([]*Event, len(events))
+	for i, dto := range events {
+		msg, err := FromEventDTO(dto)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+// ToEventDTOs converts the Event protobuf messages to their DTOs
+func ToEventDTOs(msgs []*Event) []dtos.Event {
+	events := make([]dtos.Event, len(msgs))
+	for i, msg := range msgs {
+		events[i] = ToEventDTO(msg)
+	}
+	return events
+}
+
+// FromEventModel converts the Event model to its protobuf message
+func FromEventModel(model models.Event) (*Event, errors.EdgeX) {
+	return FromEventDTO(dtos.FromEventModelToDTO(model))
+}
+
+// ToEventModel converts the Event protobuf message to its model
+func ToEventModel(msg *Event) models.Event {
+	dto := ToEventDTO(msg)
+	readings := make([]models.Reading, len(dto.Readings))
+	for i, reading := range dto.Readings {
+		readings[i] = dtos.ToReadingModel(reading)
+	}
+	return models.Event{
+		Id:          dto.Id,
+		DeviceName:  dto.DeviceName,
+		ProfileName: dto.ProfileName,
+		SourceName:  dto.SourceName,
+		Origin:      dto.Origin,
+		Readings:    readings,
+		Tags:        dto.Tags,
+	}
+}
+
+// FromAddEventRequestDTO converts the AddEventRequest DTO to its protobuf message
+func FromAddEventRequestDTO(dto requests.AddEventRequest) (*AddEventRequest, errors.EdgeX) {
+	event, err := FromEventDTO(dto.Event)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return &AddEventRequest{Base: FromBaseRequestDTO(dto.BaseRequest), Event: event}, nil
+}
+
+// ToAddEventRequestDTO converts the AddEventRequest protobuf message to its DTO
+func ToAddEventRequestDTO(msg *AddEventRequest) requests.AddEventRequest {
+	return requests.AddEventRequest{BaseRequest: ToBaseRequestDTO(msg.GetBase()), Event: ToEventDTO(msg.GetEvent())}
+}
+
+// FromEventResponseDTO converts the EventResponse DTO to its protobuf message
+func FromEventResponseDTO(dto responses.EventResponse) (*EventResponse, errors.EdgeX) {
+	event, err := FromEventDTO(dto.Event)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return &EventResponse{Base: FromBaseResponseDTO(dto.BaseResponse), Event: event}, nil
+}
+
+// ToEventResponseDTO converts the EventResponse protobuf message to its DTO
+func ToEventResponseDTO(msg *EventResponse) responses.EventResponse {
+	return responses.EventResponse{BaseResponse: ToBaseResponseDTO(msg.GetBase()), Event: ToEventDTO(msg.GetEvent())}
+}
+
+// FromMultiEventsResponseDTO converts the MultiEventsResponse DTO to its protobuf message
+func FromMultiEventsResponseDTO(dto responses.MultiEventsResponse) (*MultiEventsResponse, errors.EdgeX) {
+	events, err := FromEventDTOs(dto.Events)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return &MultiEventsResponse{Base: FromBaseResponseDTO(dto.BaseResponse), TotalCount: dto.TotalCount, Events: events}, nil
+}
+
+// ToMultiEventsResponseDTO converts the MultiEventsResponse protobuf message to its DTO
+func ToMultiEventsResponseDTO(msg *MultiEventsResponse) responses.MultiEventsResponse {
+	return responses.MultiEventsResponse{
+		BaseWithTotalCountResponse: dtoCommon.BaseWithTotalCountResponse{
+			BaseResponse: ToBaseResponseDTO(msg.GetBase()),
+			TotalCount:   msg.GetTotalCount(),
+		},
+		Events: ToEventDTOs(msg.GetEvents()),
+	}
+}
@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestEvent(t *testing.T) dtos.Event {
+	event := dtos.NewEvent("profile1", "device1", "source1")
+	require.NoError(t, event.AddSimpleReading("temperature", common.ValueTypeFloat64, 21.5))
+	event.AddBinaryReading("image", []byte{0xff, 0xd8, 0xff}, "image/jpeg")
+	event.AddObjectReading("status", map[string]interface{}{"online": true, "uptime": float64(3600)})
+	event.Tags = map[string]interface{}{"site": "plant1", "line": float64(3)}
+	return event
+}
+
+func TestEventRoundTrip(t *testing.T) {
+	event := newTestEvent(t)
+	msg, err := FromEventDTO(event)
+	require.NoError(t, err)
+
+	data, marshalErr := proto.Marshal(msg)
+	require.NoError(t, marshalErr)
+	decoded := &Event{}
+	require.NoError(t, proto.Unmarshal(data, decoded))
+	assert.Equal(t, event, ToEventDTO(decoded))
+}
+
+func TestEventModel(t *testing.T) {
+	event := newTestEvent(t)
+	msg, err := FromEventDTO(event)
+	require.NoError(t, err)
+
+	model := ToEventModel(msg)
+	assert.Equal(t, event.Id, model.Id)
+	assert.Equal(t, event.Tags, model.Tags)
+	require.Len(t, model.Readings, 3)
+	assert.IsType(t, models.SimpleReading{}, model.Readings[0])
+	assert.IsType(t, models.BinaryReading{}, model.Readings[1])
+	assert.IsType(t, models.ObjectReading{}, model.Readings[2])
+
+	fromModel, err := FromEventModel(model)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(msg, fromModel))
+}
+
+func TestAddEventRequestRoundTrip(t *testing.T) {
+	req := requests.NewAddEventRequest(newTestEvent(t))
+	msg, err := FromAddEventRequestDTO(req)
+	require.NoError(t, err)
+	assert.Equal(t, req, ToAddEventRequestDTO(msg))
+	assert.NoError(t, ToAddEventRequestDTO(msg).Validate())
+}
+
+func TestMultiEventsResponseRoundTrip(t *testing.T) {
+	res := responses.NewMultiEventsResponse("", "", http.StatusOK, 5, []dtos.Event{newTestEvent(t), newTestEvent(t)})
+	msg, err := FromMultiEventsResponseDTO(res)
+	require.NoError(t, err)
+	assert.Equal(t, res, ToMultiEventsResponseDTO(msg))
+}
+
+func TestToEventDTONil(t *testing.T) {
+	event := ToEventDTO(nil)
+	assert.Empty(t, event.Id)
+	assert.Empty(t, event.Readings)
+	assert.Nil(t, event.Tags)
+}
@@ -0,0 +1,10 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pb holds the protobuf messages and the gRPC services of the core DTOs and client interfaces, generated from
+// the .proto files of this directory, and the converters between the messages and the dtos and models types.
+package pb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative common.proto coredata.proto coremetadata.proto corecommand.proto
@@ -0,0 +1,119 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// FromReadingDTO converts the BaseReading DTO to its protobuf message, holding the value matching the ValueType
+func FromReadingDTO(dto dtos.BaseReading) (*Reading, errors.EdgeX) {
+	msg := &Reading{
+		Id:           dto.Id,
+		Origin:       dto.Origin,
+		DeviceName:   dto.DeviceName,
+		ResourceName: dto.ResourceName,
+		ProfileName:  dto.ProfileName,
+		ValueType:    dto.ValueType,
+	}
+	switch dto.ValueType {
+	case common.ValueTypeBinary:
+		msg.Value = &Reading_Binary{Binary: &BinaryValue{BinaryValue: dto.BinaryValue, MediaType: dto.MediaType}}
+	case common.ValueTypeObject:
+		value, err := toValue(dto.ObjectValue)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to convert the object value of the reading", err)
+		}
+		if value == nil {
+			value = structpb.NewNullValue()
+		}
+		msg.Value = &Reading_Object{Object: value}
+	default:
+		msg.Value = &Reading_Simple{Simple: &SimpleValue{Value: dto.Value}}
+	}
+	return msg, nil
+}
+
+// ToReadingDTO converts the Reading protobuf message to its DTO
+func ToReadingDTO(msg *Reading) dtos.BaseReading {
+	dto := dtos.BaseReading{
+		Id:           msg.GetId(),
+		Origin:       msg.GetOrigin(),
+		DeviceName:   msg.GetDeviceName(),
+		ResourceName: msg.GetResourceName(),
+		ProfileName:  msg.GetProfileName(),
+		ValueType:    msg.GetValueType(),
+	}
+	switch value := msg.GetValue().(type) {
+	case *Reading_Binary:
+		dto.BinaryReading = dtos.BinaryReading{BinaryValue: value.Binary.GetBinaryValue(), MediaType: value.Binary.GetMediaType()}
+	case *Reading_Object:
+		dto.ObjectReading = dtos.ObjectReading{ObjectValue: fromValue(value.Object)}
+	case *Reading_Simple:
+		dto.SimpleReading = dtos.SimpleReading{Value: value.Simple.GetValue()}
+	}
+	return dto
+}
+
+// FromReadingDTOs converts the BaseReading DTOs to their protobuf messages
+func FromReadingDTOs(readings []dtos.BaseReading) ([]*Reading, errors.EdgeX) {
+	msgs := make([]*Reading, len(readings))
+	for i, dto := range readings {
+		msg, err := FromReadingDTO(dto)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+// ToReadingDTOs converts the Reading protobuf messages to their DTOs
+func ToReadingDTOs(msgs []*Reading) []dtos.BaseReading {
+	readings := make([]dtos.BaseReading, len(msgs))
+	for i, msg := range msgs {
+		readings[i] = ToReadingDTO(msg)
+	}
+	return readings
+}
+
+// FromReadingModel converts the Reading model to its protobuf message
+func FromReadingModel(model models.Reading) (*Reading, errors.EdgeX) {
+	return FromReadingDTO(dtos.FromReadingModelToDTO(model))
+}
+
+// ToReadingModel converts the Reading protobuf message to its model, i.e. a SimpleReading, BinaryReading or
+// ObjectReading depending on the value type
+func ToReadingModel(msg *Reading) models.Reading {
+	return dtos.ToReadingModel(ToReadingDTO(msg))
+}
+
+// FromMultiReadingsResponseDTO converts the MultiReadingsResponse DTO to its protobuf message
+func FromMultiReadingsResponseDTO(dto responses.MultiReadingsResponse) (*MultiReadingsResponse, errors.EdgeX) {
+	readings, err := FromReadingDTOs(dto.Readings)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return &MultiReadingsResponse{Base: FromBaseResponseDTO(dto.BaseResponse), TotalCount: dto.TotalCount, Readings: readings}, nil
+}
+
+// ToMultiReadingsResponseDTO converts the MultiReadingsResponse protobuf message to its DTO
+func ToMultiReadingsResponseDTO(msg *MultiReadingsResponse) responses.MultiReadingsResponse {
+	return responses.MultiReadingsResponse{
+		BaseWithTotalCountResponse: dtoCommon.BaseWithTotalCountResponse{
+			BaseResponse: ToBaseResponseDTO(msg.GetBase()),
+			TotalCount:   msg.GetTotalCount(),
+		},
+		Readings: ToReadingDTOs(msg.GetReadings()),
+	}
+}
@@ -0,0 +1,65 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pb
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadingValues(t *testing.T) {
+	simple, err := dtos.NewSimpleReading("profile1", "device1", "temperature", common.ValueTypeInt32, int32(20))
+	require.NoError(t, err)
+	binary := dtos.NewBinaryReading("profile1", "device1", "image", []byte{1, 2, 3}, "image/png")
+	object := dtos.NewObjectReading("profile1", "device1", "status", map[string]interface{}{"online": true})
+	nilObject := dtos.NewObjectReading("profile1", "device1", "status", nil)
+
+	tests := []struct {
+		name     string
+		reading  dtos.BaseReading
+		expected interface{}
+	}{
+		{"simple", simple, &Reading_Simple{}},
+		{"binary", binary, &Reading_Binary{}},
+		{"object", object, &Reading_Object{}},
+		{"nil object", nilObject, &Reading_Object{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			msg, err := FromReadingDTO(testCase.reading)
+			require.NoError(t, err)
+			assert.IsType(t, testCase.expected, msg.GetValue(), "the value matches the value type")
+			assert.Equal(t, testCase.reading, ToReadingDTO(msg))
+		})
+	}
+}
+
+func TestReadingModel(t *testing.T) {
+	binary := dtos.NewBinaryReading("profile1", "device1", "image", []byte{1, 2, 3}, "image/png")
+	msg, err := FromReadingDTO(binary)
+	require.NoError(t, err)
+
+	model := ToReadingModel(msg)
+	require.IsType(t, models.BinaryReading{}, model)
+	assert.Equal(t, []byte{1, 2, 3}, model.(models.BinaryReading).BinaryValue)
+
+	fromModel, err := FromReadingModel(model)
+	require.NoError(t, err)
+	assert.Equal(t, binary, ToReadingDTO(fromModel))
+}
+
+func TestReadingUnsupportedObjectValue(t *testing.T) {
+	_, err := FromReadingDTO(dtos.NewObjectReading("profile1", "device1", "status", func() {}))
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
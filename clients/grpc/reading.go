@@ -0,0 +1,110 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type readingClient struct {
+	client pb.ReadingsClient
+}
+
+// NewReadingClient creates an instance of ReadingClient calling the Readings service over the gRPC connection
+func NewReadingClient(conn grpc.ClientConnInterface) interfaces.ReadingClient {
+	return &readingClient{client: pb.NewReadingsClient(conn)}
+}
+
+func (rc *readingClient) AllReadings(ctx context.Context, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	res, err := rc.client.AllReadings(outgoingContext(ctx), &pb.PageRequest{Offset: int32(offset), Limit: int32(limit)})
+	return readingsResponse(res, err)
+}
+
+func (rc *readingClient) ReadingCount(ctx context.Context) (dtoCommon.CountResponse, errors.EdgeX) {
+	res, err := rc.client.ReadingCount(outgoingContext(ctx), &emptypb.Empty{})
+	if err != nil {
+		return dtoCommon.CountResponse{}, fromStatus(err)
+	}
+	return pb.ToCountResponseDTO(res), nil
+}
+
+func (rc *readingClient) ReadingCountByDeviceName(ctx context.Context, name string) (dtoCommon.CountResponse, errors.EdgeX) {
+	res, err := rc.client.ReadingCountByDeviceName(outgoingContext(ctx), &pb.NameRequest{Name: name})
+	if err != nil {
+		return dtoCommon.CountResponse{}, fromStatus(err)
+	}
+	return pb.ToCountResponseDTO(res), nil
+}
+
+func (rc *readingClient) ReadingsByDeviceName(ctx context.Context, name string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	res, err := rc.client.ReadingsByDeviceName(outgoingContext(ctx), &pb.NamePageRequest{Name: name, Offset: int32(offset), Limit: int32(limit)})
+	return readingsResponse(res, err)
+}
+
+func (rc *readingClient) ReadingsByResourceName(ctx context.Context, name string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	res, err := rc.client.ReadingsByResourceName(outgoingContext(ctx), &pb.NamePageRequest{Name: name, Offset: int32(offset), Limit: int32(limit)})
+	return readingsResponse(res, err)
+}
+
+func (rc *readingClient) ReadingsByTimeRange(ctx context.Context, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	req := &pb.TimeRangeRequest{Start: int64(start), End: int64(end), Offset: int32(offset), Limit: int32(limit)}
+	res, err := rc.client.ReadingsByTimeRange(outgoingContext(ctx), req)
+	return readingsResponse(res, err)
+}
+
+func (rc *readingClient) ReadingsByResourceNameAndTimeRange(ctx context.Context, name string, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	req := &pb.NameTimeRangeRequest{Name: name, Start: int64(start), End: int64(end), Offset: int32(offset), Limit: int32(limit)}
+	res, err := rc.client.ReadingsByResourceNameAndTimeRange(outgoingContext(ctx), req)
+	return readingsResponse(res, err)
+}
+
+func (rc *readingClient) ReadingsByDeviceNameAndResourceName(ctx context.Context, deviceName, resourceName string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	req := &pb.ResourceNamePageRequest{DeviceName: deviceName, ResourceName: resourceName, Offset: int32(offset), Limit: int32(limit)}
+	res, err := rc.client.ReadingsByDeviceNameAndResourceName(outgoingContext(ctx), req)
+	return readingsResponse(res, err)
+}
+
+func (rc *readingClient) ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx context.Context, deviceName, resourceName string, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	req := &pb.ResourceNameTimeRangeRequest{
+		DeviceName:   deviceName,
+		ResourceName: resourceName,
+		Start:        int64(start),
+		End:          int64(end),
+		Offset:       int32(offset),
+		Limit:        int32(limit),
+	}
+	res, err := rc.client.ReadingsByDeviceNameAndResourceNameAndTimeRange(outgoingContext(ctx), req)
+	return readingsResponse(res, err)
+}
+
+func (rc *readingClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx context.Context, deviceName string, resourceNames []string, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+	req := &pb.ResourceNamesTimeRangeRequest{
+		DeviceName:    deviceName,
+		ResourceNames: resourceNames,
+		Start:         int64(start),
+		End:           int64(end),
+		Offset:        int32(offset),
+		Limit:         int32(limit),
+	}
+	res, err := rc.client.ReadingsByDeviceNameAndResourceNamesAndTimeRange(outgoingContext(ctx), req)
+	return readingsResponse(res, err)
+}
+
+func readingsResponse(res *pb.MultiReadingsResponse, err error) (responses.MultiReadingsResponse, errors.EdgeX) {
+	if err != nil {
+		return responses.MultiReadingsResponse{}, fromStatus(err)
+	}
+	return pb.ToMultiReadingsResponseDTO(res), nil
+}
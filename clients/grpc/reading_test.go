@@ -0,0 +1,28 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/conformance"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/fakes"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+
+	"google.golang.org/grpc"
+)
+
+func TestReadingClientConformance(t *testing.T) {
+	conformance.RunReadingClientSuite(t, func(t *testing.T) (interfaces.EventClient, interfaces.ReadingClient) {
+		events := fakes.NewEventClient()
+		conn := newConn(t, func(server *grpc.Server) {
+			pb.RegisterEventsServer(server, NewEventServer(events))
+			pb.RegisterReadingsServer(server, NewReadingServer(fakes.NewReadingClient(events)))
+		})
+		return NewEventClient(conn), NewReadingClient(conn)
+	})
+}
@@ -0,0 +1,97 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/grpc/pb"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type readingServer struct {
+	pb.UnimplementedReadingsServer
+	readings interfaces.ReadingClient
+}
+
+// NewReadingServer creates the Readings service serving the calls with the ReadingClient, e.g. the HTTP client of
+// core-data or an in-memory fake
+func NewReadingServer(readings interfaces.ReadingClient) pb.ReadingsServer {
+	return &readingServer{readings: readings}
+}
+
+func (s *readingServer) AllReadings(ctx context.Context, msg *pb.PageRequest) (*pb.MultiReadingsResponse, error) {
+	res, err := s.readings.AllReadings(incomingContext(ctx), int(msg.GetOffset()), int(msg.GetLimit()))
+	return readingsMessage(res, err)
+}
+
+func (s *readingServer) ReadingCount(ctx context.Context, _ *emptypb.Empty) (*pb.CountResponse, error) {
+	res, err := s.readings.ReadingCount(incomingContext(ctx))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromCountResponseDTO(res), nil
+}
+
+func (s *readingServer) ReadingCountByDeviceName(ctx context.Context, msg *pb.NameRequest) (*pb.CountResponse, error) {
+	res, err := s.readings.ReadingCountByDeviceName(incomingContext(ctx), msg.GetName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return pb.FromCountResponseDTO(res), nil
+}
+
+func (s *readingServer) ReadingsByDeviceName(ctx context.Context, msg *pb.NamePageRequest) (*pb.MultiReadingsResponse, error) {
+	res, err := s.readings.ReadingsByDeviceName(incomingContext(ctx), msg.GetName(), int(msg.GetOffset()), int(msg.GetLimit()))
+	return readingsMessage(res, err)
+}
+
+func (s *readingServer) ReadingsByResourceName(ctx context.Context, msg *pb.NamePageRequest) (*pb.MultiReadingsResponse, error) {
+	res, err := s.readings.ReadingsByResourceName(incomingContext(ctx), msg.GetName(), int(msg.GetOffset()), int(msg.GetLimit()))
+	return readingsMessage(res, err)
+}
+
+func (s *readingServer) ReadingsByTimeRange(ctx context.Context, msg *pb.TimeRangeRequest) (*pb.MultiReadingsResponse, error) {
+	res, err := s.readings.ReadingsByTimeRange(incomingContext(ctx), int(msg.GetStart()), int(msg.GetEnd()), int(msg.GetOffset()), int(msg.GetLimit()))
+	return readingsMessage(res, err)
+}
+
+func (s *readingServer) ReadingsByResourceNameAndTimeRange(ctx context.Context, msg *pb.NameTimeRangeRequest) (*pb.MultiReadingsResponse, error) {
+	res, err := s.readings.ReadingsByResourceNameAndTimeRange(incomingContext(ctx), msg.GetName(), int(msg.GetStart()), int(msg.GetEnd()), int(msg.GetOffset()), int(msg.GetLimit()))
+	return readingsMessage(res, err)
+}
+
+func (s *readingServer) ReadingsByDeviceNameAndResourceName(ctx context.Context, msg *pb.ResourceNamePageRequest) (*pb.MultiReadingsResponse, error) {
+	res, err := s.readings.ReadingsByDeviceNameAndResourceName(incomingContext(ctx), msg.GetDeviceName(), msg.GetResourceName(), int(msg.GetOffset()), int(msg.GetLimit()))
+	return readingsMessage(res, err)
+}
+
+func (s *readingServer) ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx context.Context, msg *pb.ResourceNameTimeRangeRequest) (*pb.MultiReadingsResponse, error) {
+	res, err := s.readings.ReadingsByDeviceNameAndResourceNameAndTimeRange(incomingContext(ctx), msg.GetDeviceName(), msg.GetResourceName(),
+		int(msg.GetStart()), int(msg.GetEnd()), int(msg.GetOffset()), int(msg.GetLimit()))
+	return readingsMessage(res, err)
+}
+
+func (s *readingServer) ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx context.Context, msg *pb.ResourceNamesTimeRangeRequest) (*pb.MultiReadingsResponse, error) {
+	res, err := s.readings.ReadingsByDeviceNameAndResourceNamesAndTimeRange(incomingContext(ctx), msg.GetDeviceName(), msg.GetResourceNames(),
+		int(msg.GetStart()), int(msg.GetEnd()), int(msg.GetOffset()), int(msg.GetLimit()))
+	return readingsMessage(res, err)
+}
+
+func readingsMessage(res responses.MultiReadingsResponse, err errors.EdgeX) (*pb.MultiReadingsResponse, error) {
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	msg, err := pb.FromMultiReadingsResponseDTO(res)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return msg, nil
+}
@@ -20,13 +20,15 @@ import (
 )
 
 type CommandClient struct {
-	baseUrl string
+	baseUrl   string
+	requester *utils.Requester
 }
 
 // NewCommandClient creates an instance of CommandClient
-func NewCommandClient(baseUrl string) interfaces.CommandClient {
+func NewCommandClient(baseUrl string, opts ...utils.ClientOption) interfaces.CommandClient {
 	return &CommandClient{
-		baseUrl: baseUrl,
+		baseUrl:   baseUrl,
+		requester: utils.NewRequester(opts...),
 	}
 }
 
@@ -36,7 +38,7 @@ func (client *CommandClient) AllDeviceCoreCommands(ctx context.Context, offset i
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllDeviceRoute, requestParams)
+	err = client.requester.GetRequest(ctx, &res, client.baseUrl, common.ApiAllDeviceRoute, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -47,7 +49,7 @@ func (client *CommandClient) AllDeviceCoreCommands(ctx context.Context, offset i
 func (client *CommandClient) DeviceCoreCommandsByDeviceName(ctx context.Context, name string) (
 	res responses.DeviceCoreCommandResponse, err errors.EdgeX) {
 	path := path.Join(common.ApiDeviceRoute, common.Name, url.QueryEscape(name))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, path, nil)
+	err = client.requester.GetRequest(ctx, &res, client.baseUrl, path, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -60,7 +62,7 @@ func (client *CommandClient) IssueGetCommandByName(ctx context.Context, deviceNa
 	requestParams.Set(common.PushEvent, dsPushEvent)
 	requestParams.Set(common.ReturnEvent, dsReturnEvent)
 	requestPath := path.Join(common.ApiDeviceRoute, common.Name, url.QueryEscape(deviceName), url.QueryEscape(commandName))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = client.requester.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -70,7 +72,7 @@ func (client *CommandClient) IssueGetCommandByName(ctx context.Context, deviceNa
 // IssueSetCommandByName issues the specified write command referenced by the command name to the device/sensor that is also referenced by name.
 func (client *CommandClient) IssueSetCommandByName(ctx context.Context, deviceName string, commandName string, settings map[string]string) (res dtoCommon.BaseResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiDeviceRoute, common.Name, url.QueryEscape(deviceName), url.QueryEscape(commandName))
-	err = utils.PutRequest(ctx, &res, client.baseUrl+requestPath, settings)
+	err = client.requester.PutRequest(ctx, &res, client.baseUrl+requestPath, settings)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -80,7 +82,7 @@ func (client *CommandClient) IssueSetCommandByName(ctx context.Context, deviceNa
 // IssueSetCommandByNameWithObject issues the specified write command and the settings supports object value type
 func (client *CommandClient) IssueSetCommandByNameWithObject(ctx context.Context, deviceName string, commandName string, settings map[string]interface{}) (res dtoCommon.BaseResponse, err errors.EdgeX) {
 	requestPath := path.Join(common.ApiDeviceRoute, common.Name, url.QueryEscape(deviceName), url.QueryEscape(commandName))
-	err = utils.PutRequest(ctx, &res, client.baseUrl+requestPath, settings)
+	err = client.requester.PutRequest(ctx, &res, client.baseUrl+requestPath, settings)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -11,9 +11,11 @@ import (
 	"path"
 	"testing"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 
 	"github.com/stretchr/testify/require"
 )
@@ -82,3 +84,22 @@ func TestIssueIssueSetCommandByNameWithObject(t *testing.T) {
 	require.NoError(t, err)
 	require.IsType(t, dtoCommon.BaseResponse{}, res)
 }
+
+func TestIssueSetCommandByNameWithRateLimiter(t *testing.T) {
+	deviceName := "Simple-Device01"
+	cmdName := "SwitchButton"
+	path := path.Join(common.ApiDeviceRoute, common.Name, deviceName, cmdName)
+	ts := newTestServer(http.MethodPut, path, dtoCommon.BaseResponse{})
+	defer ts.Close()
+
+	limiter := utils.NewRateLimiter(utils.RateLimiterSettings{
+		PerMethod: map[string]utils.RateLimit{http.MethodPut: {RequestsPerSecond: 1}},
+		FailFast:  true,
+	})
+	client := NewCommandClient(ts.URL, utils.WithRateLimiter(limiter))
+	_, err := client.IssueSetCommandByName(context.Background(), deviceName, cmdName, map[string]string{cmdName: "true"})
+	require.NoError(t, err)
+	_, err = client.IssueSetCommandByName(context.Background(), deviceName, cmdName, map[string]string{cmdName: "false"})
+	require.Error(t, err)
+	require.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+}
@@ -16,19 +16,21 @@ import (
 )
 
 type commonClient struct {
-	baseUrl string
+	baseUrl   string
+	requester *utils.Requester
 }
 
 // NewCommonClient creates an instance of CommonClient
-func NewCommonClient(baseUrl string) interfaces.CommonClient {
+func NewCommonClient(baseUrl string, opts ...utils.ClientOption) interfaces.CommonClient {
 	return &commonClient{
-		baseUrl: baseUrl,
+		baseUrl:   baseUrl,
+		requester: utils.NewRequester(opts...),
 	}
 }
 
 func (cc *commonClient) Configuration(ctx context.Context) (dtoCommon.ConfigResponse, errors.EdgeX) {
 	cr := dtoCommon.ConfigResponse{}
-	err := utils.GetRequest(ctx, &cr, cc.baseUrl, common.ApiConfigRoute, nil)
+	err := cc.requester.GetRequest(ctx, &cr, cc.baseUrl, common.ApiConfigRoute, nil)
 	if err != nil {
 		return cr, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -37,7 +39,7 @@ func (cc *commonClient) Configuration(ctx context.Context) (dtoCommon.ConfigResp
 
 func (cc *commonClient) Metrics(ctx context.Context) (dtoCommon.MetricsResponse, errors.EdgeX) {
 	mr := dtoCommon.MetricsResponse{}
-	err := utils.GetRequest(ctx, &mr, cc.baseUrl, common.ApiMetricsRoute, nil)
+	err := cc.requester.GetRequest(ctx, &mr, cc.baseUrl, common.ApiMetricsRoute, nil)
 	if err != nil {
 		return mr, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -46,7 +48,7 @@ func (cc *commonClient) Metrics(ctx context.Context) (dtoCommon.MetricsResponse,
 
 func (cc *commonClient) Ping(ctx context.Context) (dtoCommon.PingResponse, errors.EdgeX) {
 	pr := dtoCommon.PingResponse{}
-	err := utils.GetRequest(ctx, &pr, cc.baseUrl, common.ApiPingRoute, nil)
+	err := cc.requester.GetRequest(ctx, &pr, cc.baseUrl, common.ApiPingRoute, nil)
 	if err != nil {
 		return pr, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -55,7 +57,7 @@ func (cc *commonClient) Ping(ctx context.Context) (dtoCommon.PingResponse, error
 
 func (cc *commonClient) Version(ctx context.Context) (dtoCommon.VersionResponse, errors.EdgeX) {
 	vr := dtoCommon.VersionResponse{}
-	err := utils.GetRequest(ctx, &vr, cc.baseUrl, common.ApiVersionRoute, nil)
+	err := cc.requester.GetRequest(ctx, &vr, cc.baseUrl, common.ApiVersionRoute, nil)
 	if err != nil {
 		return vr, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -63,7 +65,7 @@ func (cc *commonClient) Version(ctx context.Context) (dtoCommon.VersionResponse,
 }
 
 func (cc *commonClient) AddSecret(ctx context.Context, request dtoCommon.SecretRequest) (res dtoCommon.BaseResponse, err errors.EdgeX) {
-	err = utils.PostRequestWithRawData(ctx, &res, cc.baseUrl+common.ApiSecretRoute, request)
+	err = cc.requester.PostRequestWithRawData(ctx, &res, cc.baseUrl+common.ApiSecretRoute, request)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
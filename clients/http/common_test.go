@@ -99,3 +99,13 @@ func newTestServer(httpMethod string, apiRoute string, expectedResponse interfac
 		_, _ = w.Write(b)
 	}))
 }
+
+// countingTransport counts the requests sent through the injected http.Client
+type countingTransport struct {
+	count int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.count++
+	return http.DefaultTransport.RoundTrip(req)
+}
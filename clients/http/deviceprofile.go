@@ -25,14 +25,16 @@ import (
 
 type DeviceProfileClient struct {
 	baseUrl        string
+	requester      *utils.Requester
 	resourcesCache map[string]responses.DeviceResourceResponse
 	mux            sync.RWMutex
 }
 
 // NewDeviceProfileClient creates an instance of DeviceProfileClient
-func NewDeviceProfileClient(baseUrl string) interfaces.DeviceProfileClient {
+func NewDeviceProfileClient(baseUrl string, opts ...utils.ClientOption) interfaces.DeviceProfileClient {
 	return &DeviceProfileClient{
 		baseUrl:        baseUrl,
+		requester:      utils.NewRequester(opts...),
 		resourcesCache: make(map[string]responses.DeviceResourceResponse),
 	}
 }
@@ -40,7 +42,7 @@ func NewDeviceProfileClient(baseUrl string) interfaces.DeviceProfileClient {
 // Add adds new device profile
 func (client *DeviceProfileClient) Add(ctx context.Context, reqs []requests.DeviceProfileRequest) ([]dtoCommon.BaseWithIdResponse, errors.EdgeX) {
 	var responses []dtoCommon.BaseWithIdResponse
-	err := utils.PostRequestWithRawData(ctx, &responses, client.baseUrl+common.ApiDeviceProfileRoute, reqs)
+	err := client.requester.PostRequestWithRawData(ctx, &responses, client.baseUrl+common.ApiDeviceProfileRoute, reqs)
 	if err != nil {
 		return responses, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -50,7 +52,7 @@ func (client *DeviceProfileClient) Add(ctx context.Context, reqs []requests.Devi
 // Update updates device profile
 func (client *DeviceProfileClient) Update(ctx context.Context, reqs []requests.DeviceProfileRequest) ([]dtoCommon.BaseResponse, errors.EdgeX) {
 	var responses []dtoCommon.BaseResponse
-	err := utils.PutRequest(ctx, &responses, client.baseUrl+common.ApiDeviceProfileRoute, reqs)
+	err := client.requester.PutRequest(ctx, &responses, client.baseUrl+common.ApiDeviceProfileRoute, reqs)
 	if err != nil {
 		return responses, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -60,7 +62,7 @@ func (client *DeviceProfileClient) Update(ctx context.Context, reqs []requests.D
 // AddByYaml adds new device profile by uploading a yaml file
 func (client *DeviceProfileClient) AddByYaml(ctx context.Context, yamlFilePath string) (dtoCommon.BaseWithIdResponse, errors.EdgeX) {
 	var responses dtoCommon.BaseWithIdResponse
-	err := utils.PostByFileRequest(ctx, &responses, client.baseUrl+common.ApiDeviceProfileUploadFileRoute, yamlFilePath)
+	err := client.requester.PostByFileRequest(ctx, &responses, client.baseUrl+common.ApiDeviceProfileUploadFileRoute, yamlFilePath)
 	if err != nil {
 		return responses, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -70,7 +72,7 @@ func (client *DeviceProfileClient) AddByYaml(ctx context.Context, yamlFilePath s
 // UpdateByYaml updates device profile by uploading a yaml file
 func (client *DeviceProfileClient) UpdateByYaml(ctx context.Context, yamlFilePath string) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var responses dtoCommon.BaseResponse
-	err := utils.PutByFileRequest(ctx, &responses, client.baseUrl+common.ApiDeviceProfileUploadFileRoute, yamlFilePath)
+	err := client.requester.PutByFileRequest(ctx, &responses, client.baseUrl+common.ApiDeviceProfileUploadFileRoute, yamlFilePath)
 	if err != nil {
 		return responses, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -81,7 +83,7 @@ func (client *DeviceProfileClient) UpdateByYaml(ctx context.Context, yamlFilePat
 func (client *DeviceProfileClient) DeleteByName(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
 	requestPath := path.Join(common.ApiDeviceProfileRoute, common.Name, url.QueryEscape(name))
-	err := utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
+	err := client.requester.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -91,7 +93,7 @@ func (client *DeviceProfileClient) DeleteByName(ctx context.Context, name string
 // DeviceProfileByName queries the device profile by name
 func (client *DeviceProfileClient) DeviceProfileByName(ctx context.Context, name string) (res responses.DeviceProfileResponse, edgexError errors.EdgeX) {
 	requestPath := path.Join(common.ApiDeviceProfileRoute, common.Name, url.QueryEscape(name))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil)
+	err := client.requester.GetRequest(ctx, &res, client.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -106,7 +108,7 @@ func (client *DeviceProfileClient) AllDeviceProfiles(ctx context.Context, labels
 	}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllDeviceProfileRoute, requestParams)
+	err := client.requester.GetRequest(ctx, &res, client.baseUrl, common.ApiAllDeviceProfileRoute, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -119,7 +121,7 @@ func (client *DeviceProfileClient) DeviceProfilesByModel(ctx context.Context, mo
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err := client.requester.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -132,7 +134,7 @@ func (client *DeviceProfileClient) DeviceProfilesByManufacturer(ctx context.Cont
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err := client.requester.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -145,7 +147,7 @@ func (client *DeviceProfileClient) DeviceProfilesByManufacturerAndModel(ctx cont
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err := client.requester.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -160,7 +162,7 @@ func (client *DeviceProfileClient) DeviceResourceByProfileNameAndResourceName(ct
 		return res, nil
 	}
 	requestPath := path.Join(common.ApiDeviceResourceRoute, common.Profile, url.QueryEscape(profileName), common.Resource, url.QueryEscape(resourceName))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil)
+	err := client.requester.GetRequest(ctx, &res, client.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
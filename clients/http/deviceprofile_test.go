@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"runtime"
 	"testing"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
@@ -241,3 +243,39 @@ func TestDeviceResourceByProfileNameAndResourceName(t *testing.T) {
 	require.NoError(t, err)
 	assert.IsType(t, responses.DeviceResourceResponse{}, res)
 }
+
+func TestDeviceProfileClientOverUnixSocket(t *testing.T) {
+	profileName := "testProfile"
+	socketPath := filepath.Join(t.TempDir(), "core-metadata.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.EscapedPath() == path.Join(common.ApiDeviceProfileRoute, common.Name, profileName):
+			res, _ := json.Marshal(responses.DeviceProfileResponse{})
+			_, _ = w.Write(res)
+		case r.Method == http.MethodPost && r.URL.EscapedPath() == common.ApiDeviceProfileUploadFileRoute:
+			if _, _, err := r.FormFile("file"); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			res, _ := json.Marshal(dtoCommon.NewBaseWithIdResponse("", "", http.StatusCreated, uuid.New().String()))
+			_, _ = w.Write(res)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	ts.Listener = listener
+	ts.Start()
+	defer ts.Close()
+
+	client := NewDeviceProfileClient(utils.UnixSocketScheme + "://" + socketPath)
+	_, err = client.DeviceProfileByName(context.Background(), profileName)
+	require.NoError(t, err)
+
+	_, b, _, _ := runtime.Caller(0)
+	res, err := client.AddByYaml(context.Background(), filepath.Dir(b)+"/data/sample-profile.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+}
@@ -17,19 +17,21 @@ import (
 )
 
 type DeviceServiceClient struct {
-	baseUrl string
+	baseUrl   string
+	requester *utils.Requester
 }
 
 // NewDeviceServiceClient creates an instance of DeviceServiceClient
-func NewDeviceServiceClient(baseUrl string) interfaces.DeviceServiceClient {
+func NewDeviceServiceClient(baseUrl string, opts ...utils.ClientOption) interfaces.DeviceServiceClient {
 	return &DeviceServiceClient{
-		baseUrl: baseUrl,
+		baseUrl:   baseUrl,
+		requester: utils.NewRequester(opts...),
 	}
 }
 
 func (dsc DeviceServiceClient) Add(ctx context.Context, reqs []requests.AddDeviceServiceRequest) (
 	res []dtoCommon.BaseWithIdResponse, err errors.EdgeX) {
-	err = utils.PostRequestWithRawData(ctx, &res, dsc.baseUrl+common.ApiDeviceServiceRoute, reqs)
+	err = dsc.requester.PostRequestWithRawData(ctx, &res, dsc.baseUrl+common.ApiDeviceServiceRoute, reqs)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -38,7 +40,7 @@ func (dsc DeviceServiceClient) Add(ctx context.Context, reqs []requests.AddDevic
 
 func (dsc DeviceServiceClient) Update(ctx context.Context, reqs []requests.UpdateDeviceServiceRequest) (
 	res []dtoCommon.BaseResponse, err errors.EdgeX) {
-	err = utils.PatchRequest(ctx, &res, dsc.baseUrl+common.ApiDeviceServiceRoute, reqs)
+	err = dsc.requester.PatchRequest(ctx, &res, dsc.baseUrl+common.ApiDeviceServiceRoute, reqs)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -53,7 +55,7 @@ func (dsc DeviceServiceClient) AllDeviceServices(ctx context.Context, labels []s
 	}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, dsc.baseUrl, common.ApiAllDeviceServiceRoute, requestParams)
+	err = dsc.requester.GetRequest(ctx, &res, dsc.baseUrl, common.ApiAllDeviceServiceRoute, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -63,7 +65,7 @@ func (dsc DeviceServiceClient) AllDeviceServices(ctx context.Context, labels []s
 func (dsc DeviceServiceClient) DeviceServiceByName(ctx context.Context, name string) (
 	res responses.DeviceServiceResponse, err errors.EdgeX) {
 	path := path.Join(common.ApiDeviceServiceRoute, common.Name, url.QueryEscape(name))
-	err = utils.GetRequest(ctx, &res, dsc.baseUrl, path, nil)
+	err = dsc.requester.GetRequest(ctx, &res, dsc.baseUrl, path, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -73,7 +75,7 @@ func (dsc DeviceServiceClient) DeviceServiceByName(ctx context.Context, name str
 func (dsc DeviceServiceClient) DeleteByName(ctx context.Context, name string) (
 	res dtoCommon.BaseResponse, err errors.EdgeX) {
 	path := path.Join(common.ApiDeviceServiceRoute, common.Name, url.QueryEscape(name))
-	err = utils.DeleteRequest(ctx, &res, dsc.baseUrl, path)
+	err = dsc.requester.DeleteRequest(ctx, &res, dsc.baseUrl, path)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -18,19 +18,21 @@ import (
 )
 
 type deviceServiceCallbackClient struct {
-	baseUrl string
+	baseUrl   string
+	requester *utils.Requester
 }
 
 // NewDeviceServiceCallbackClient creates an instance of deviceServiceCallbackClient
-func NewDeviceServiceCallbackClient(baseUrl string) interfaces.DeviceServiceCallbackClient {
+func NewDeviceServiceCallbackClient(baseUrl string, opts ...utils.ClientOption) interfaces.DeviceServiceCallbackClient {
 	return &deviceServiceCallbackClient{
-		baseUrl: baseUrl,
+		baseUrl:   baseUrl,
+		requester: utils.NewRequester(opts...),
 	}
 }
 
 func (client *deviceServiceCallbackClient) AddDeviceCallback(ctx context.Context, request requests.AddDeviceRequest) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
-	err := utils.PostRequestWithRawData(ctx, &response, client.baseUrl+common.ApiDeviceCallbackRoute, request)
+	err := client.requester.PostRequestWithRawData(ctx, &response, client.baseUrl+common.ApiDeviceCallbackRoute, request)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -39,7 +41,7 @@ func (client *deviceServiceCallbackClient) AddDeviceCallback(ctx context.Context
 
 func (client *deviceServiceCallbackClient) UpdateDeviceCallback(ctx context.Context, request requests.UpdateDeviceRequest) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
-	err := utils.PutRequest(ctx, &response, client.baseUrl+common.ApiDeviceCallbackRoute, request)
+	err := client.requester.PutRequest(ctx, &response, client.baseUrl+common.ApiDeviceCallbackRoute, request)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -49,7 +51,7 @@ func (client *deviceServiceCallbackClient) UpdateDeviceCallback(ctx context.Cont
 func (client *deviceServiceCallbackClient) DeleteDeviceCallback(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
 	requestPath := path.Join(common.ApiDeviceCallbackRoute, common.Name, name)
-	err := utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
+	err := client.requester.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -58,7 +60,7 @@ func (client *deviceServiceCallbackClient) DeleteDeviceCallback(ctx context.Cont
 
 func (client *deviceServiceCallbackClient) UpdateDeviceProfileCallback(ctx context.Context, request requests.DeviceProfileRequest) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
-	err := utils.PutRequest(ctx, &response, client.baseUrl+common.ApiProfileCallbackRoute, request)
+	err := client.requester.PutRequest(ctx, &response, client.baseUrl+common.ApiProfileCallbackRoute, request)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -67,7 +69,7 @@ func (client *deviceServiceCallbackClient) UpdateDeviceProfileCallback(ctx conte
 
 func (client *deviceServiceCallbackClient) AddProvisionWatcherCallback(ctx context.Context, request requests.AddProvisionWatcherRequest) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
-	err := utils.PostRequestWithRawData(ctx, &response, client.baseUrl+common.ApiWatcherCallbackRoute, request)
+	err := client.requester.PostRequestWithRawData(ctx, &response, client.baseUrl+common.ApiWatcherCallbackRoute, request)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -76,7 +78,7 @@ func (client *deviceServiceCallbackClient) AddProvisionWatcherCallback(ctx conte
 
 func (client *deviceServiceCallbackClient) UpdateProvisionWatcherCallback(ctx context.Context, request requests.UpdateProvisionWatcherRequest) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
-	err := utils.PutRequest(ctx, &response, client.baseUrl+common.ApiWatcherCallbackRoute, request)
+	err := client.requester.PutRequest(ctx, &response, client.baseUrl+common.ApiWatcherCallbackRoute, request)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -86,7 +88,7 @@ func (client *deviceServiceCallbackClient) UpdateProvisionWatcherCallback(ctx co
 func (client *deviceServiceCallbackClient) DeleteProvisionWatcherCallback(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
 	requestPath := path.Join(common.ApiWatcherCallbackRoute, common.Name, name)
-	err := utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
+	err := client.requester.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -95,7 +97,7 @@ func (client *deviceServiceCallbackClient) DeleteProvisionWatcherCallback(ctx co
 
 func (client *deviceServiceCallbackClient) UpdateDeviceServiceCallback(ctx context.Context, request requests.UpdateDeviceServiceRequest) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
-	err := utils.PutRequest(ctx, &response, client.baseUrl+common.ApiServiceCallbackRoute, request)
+	err := client.requester.PutRequest(ctx, &response, client.baseUrl+common.ApiServiceCallbackRoute, request)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -21,11 +21,15 @@ import (
 	"github.com/fxamacker/cbor/v2"
 )
 
-type deviceServiceCommandClient struct{}
+type deviceServiceCommandClient struct {
+	requester *utils.Requester
+}
 
 // NewDeviceServiceCommandClient creates an instance of deviceServiceCommandClient
-func NewDeviceServiceCommandClient() interfaces.DeviceServiceCommandClient {
-	return &deviceServiceCommandClient{}
+func NewDeviceServiceCommandClient(opts ...utils.ClientOption) interfaces.DeviceServiceCommandClient {
+	return &deviceServiceCommandClient{
+		requester: utils.NewRequester(opts...),
+	}
 }
 
 // GetCommand sends HTTP request to execute the Get command
@@ -35,7 +39,7 @@ func (client *deviceServiceCommandClient) GetCommand(ctx context.Context, baseUr
 	if err != nil {
 		return nil, errors.NewCommonEdgeXWrapper(err)
 	}
-	res, contentType, edgeXerr := utils.GetRequestAndReturnBinaryRes(ctx, baseUrl, requestPath, params)
+	res, contentType, edgeXerr := client.requester.GetRequestAndReturnBinaryRes(ctx, baseUrl, requestPath, params)
 	if edgeXerr != nil {
 		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
@@ -61,7 +65,7 @@ func (client *deviceServiceCommandClient) GetCommand(ctx context.Context, baseUr
 func (client *deviceServiceCommandClient) SetCommand(ctx context.Context, baseUrl string, deviceName string, commandName string, queryParams string, settings map[string]string) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
 	requestPath := path.Join(common.ApiDeviceRoute, common.Name, url.QueryEscape(deviceName), url.QueryEscape(commandName))
-	err := utils.PutRequest(ctx, &response, baseUrl+requestPath+"?"+queryParams, settings)
+	err := client.requester.PutRequest(ctx, &response, baseUrl+requestPath+"?"+queryParams, settings)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -72,7 +76,7 @@ func (client *deviceServiceCommandClient) SetCommand(ctx context.Context, baseUr
 func (client *deviceServiceCommandClient) SetCommandWithObject(ctx context.Context, baseUrl string, deviceName string, commandName string, queryParams string, settings map[string]interface{}) (dtoCommon.BaseResponse, errors.EdgeX) {
 	var response dtoCommon.BaseResponse
 	requestPath := path.Join(common.ApiDeviceRoute, common.Name, url.QueryEscape(deviceName), url.QueryEscape(commandName))
-	err := utils.PutRequest(ctx, &response, baseUrl+requestPath+"?"+queryParams, settings)
+	err := client.requester.PutRequest(ctx, &response, baseUrl+requestPath+"?"+queryParams, settings)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -21,13 +21,15 @@ import (
 )
 
 type eventClient struct {
-	baseUrl string
+	baseUrl   string
+	requester *utils.Requester
 }
 
 // NewEventClient creates an instance of EventClient
-func NewEventClient(baseUrl string) interfaces.EventClient {
+func NewEventClient(baseUrl string, opts ...utils.ClientOption) interfaces.EventClient {
 	return &eventClient{
-		baseUrl: baseUrl,
+		baseUrl:   baseUrl,
+		requester: utils.NewRequester(opts...),
 	}
 }
 
@@ -41,7 +43,7 @@ func (ec *eventClient) Add(ctx context.Context, req requests.AddEventRequest) (
 		return br, errors.NewCommonEdgeXWrapper(err)
 	}
 
-	err = utils.PostRequest(ctx, &br, ec.baseUrl+path, bytes, encoding)
+	err = ec.requester.PostRequest(ctx, &br, ec.baseUrl+path, bytes, encoding)
 	if err != nil {
 		return br, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -53,7 +55,7 @@ func (ec *eventClient) AllEvents(ctx context.Context, offset, limit int) (respon
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
 	res := responses.MultiEventsResponse{}
-	err := utils.GetRequest(ctx, &res, ec.baseUrl, common.ApiAllEventRoute, requestParams)
+	err := ec.requester.GetRequest(ctx, &res, ec.baseUrl, common.ApiAllEventRoute, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -62,7 +64,7 @@ func (ec *eventClient) AllEvents(ctx context.Context, offset, limit int) (respon
 
 func (ec *eventClient) EventCount(ctx context.Context) (dtoCommon.CountResponse, errors.EdgeX) {
 	res := dtoCommon.CountResponse{}
-	err := utils.GetRequest(ctx, &res, ec.baseUrl, common.ApiEventCountRoute, nil)
+	err := ec.requester.GetRequest(ctx, &res, ec.baseUrl, common.ApiEventCountRoute, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -72,7 +74,7 @@ func (ec *eventClient) EventCount(ctx context.Context) (dtoCommon.CountResponse,
 func (ec *eventClient) EventCountByDeviceName(ctx context.Context, name string) (dtoCommon.CountResponse, errors.EdgeX) {
 	requestPath := path.Join(common.ApiEventCountRoute, common.Device, common.Name, url.QueryEscape(name))
 	res := dtoCommon.CountResponse{}
-	err := utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, nil)
+	err := ec.requester.GetRequest(ctx, &res, ec.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -86,7 +88,7 @@ func (ec *eventClient) EventsByDeviceName(ctx context.Context, name string, offs
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
 	res := responses.MultiEventsResponse{}
-	err := utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, requestParams)
+	err := ec.requester.GetRequest(ctx, &res, ec.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -96,7 +98,7 @@ func (ec *eventClient) EventsByDeviceName(ctx context.Context, name string, offs
 func (ec *eventClient) DeleteByDeviceName(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
 	path := path.Join(common.ApiEventRoute, common.Device, common.Name, url.QueryEscape(name))
 	res := dtoCommon.BaseResponse{}
-	err := utils.DeleteRequest(ctx, &res, ec.baseUrl, path)
+	err := ec.requester.DeleteRequest(ctx, &res, ec.baseUrl, path)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -110,7 +112,7 @@ func (ec *eventClient) EventsByTimeRange(ctx context.Context, start, end, offset
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
 	res := responses.MultiEventsResponse{}
-	err := utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, requestParams)
+	err := ec.requester.GetRequest(ctx, &res, ec.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -120,7 +122,7 @@ func (ec *eventClient) EventsByTimeRange(ctx context.Context, start, end, offset
 func (ec *eventClient) DeleteByAge(ctx context.Context, age int) (dtoCommon.BaseResponse, errors.EdgeX) {
 	path := path.Join(common.ApiEventRoute, common.Age, strconv.Itoa(age))
 	res := dtoCommon.BaseResponse{}
-	err := utils.DeleteRequest(ctx, &res, ec.baseUrl, path)
+	err := ec.requester.DeleteRequest(ctx, &res, ec.baseUrl, path)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -11,7 +11,9 @@ import (
 	"path"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
 	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
@@ -114,3 +116,18 @@ func TestDeleteEventsByAge(t *testing.T) {
 	require.NoError(t, err)
 	assert.IsType(t, dtoCommon.BaseResponse{}, res)
 }
+
+func TestEventClientWithHTTPClient(t *testing.T) {
+	event := dtos.Event{ProfileName: "profileName", DeviceName: "deviceName"}
+	apiRoute := path.Join(common.ApiEventRoute, event.ProfileName, event.DeviceName)
+	ts := newTestServer(http.MethodPost, apiRoute, dtoCommon.BaseWithIdResponse{})
+	defer ts.Close()
+
+	transport := &countingTransport{}
+	client := NewEventClient(ts.URL, utils.WithHTTPClient(&http.Client{Transport: transport}), utils.WithTimeout(time.Second))
+	for i := 0; i < 3; i++ {
+		_, err := client.Add(context.Background(), requests.AddEventRequest{Event: event})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 3, transport.count)
+}
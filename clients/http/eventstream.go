@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const eventsField = "events"
+
+// NewEventStreamClient creates an instance of EventStreamClient
+func NewEventStreamClient(baseUrl string, opts ...utils.ClientOption) interfaces.EventStreamClient {
+	return &eventClient{
+		baseUrl:   baseUrl,
+		requester: utils.NewRequester(opts...),
+	}
+}
+
+func (ec *eventClient) StreamAllEvents(ctx context.Context, offset, limit int, handler interfaces.EventHandler) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	return ec.streamEvents(ctx, common.ApiAllEventRoute, offset, limit, handler)
+}
+
+func (ec *eventClient) StreamEventsByDeviceName(ctx context.Context, name string, offset, limit int, handler interfaces.EventHandler) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestPath := path.Join(common.ApiEventRoute, common.Device, common.Name, url.QueryEscape(name))
+	return ec.streamEvents(ctx, requestPath, offset, limit, handler)
+}
+
+func (ec *eventClient) StreamEventsByTimeRange(ctx context.Context, start, end, offset, limit int, handler interfaces.EventHandler) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestPath := path.Join(common.ApiEventRoute, common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	return ec.streamEvents(ctx, requestPath, offset, limit, handler)
+}
+
+// streamEvents queries the events of the requestPath and hands them over to the handler one at a time
+func (ec *eventClient) streamEvents(ctx context.Context, requestPath string, offset, limit int, handler interfaces.EventHandler) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	decodeItem := func(decoder *json.Decoder) error {
+		var event dtos.Event
+		if err := decoder.Decode(&event); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the event", err)
+		}
+		return handler(event)
+	}
+
+	res := dtoCommon.BaseWithTotalCountResponse{}
+	err := ec.requester.GetRequestAndStreamArray(ctx, &res, ec.baseUrl, requestPath, requestParams, eventsField, decodeItem)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
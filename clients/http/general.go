@@ -16,17 +16,19 @@ import (
 )
 
 type generalClient struct {
-	baseUrl string
+	baseUrl   string
+	requester *utils.Requester
 }
 
-func NewGeneralClient(baseUrl string) interfaces.GeneralClient {
+func NewGeneralClient(baseUrl string, opts ...utils.ClientOption) interfaces.GeneralClient {
 	return &generalClient{
-		baseUrl: baseUrl,
+		baseUrl:   baseUrl,
+		requester: utils.NewRequester(opts...),
 	}
 }
 
 func (g *generalClient) FetchConfiguration(ctx context.Context) (res dtoCommon.ConfigResponse, err errors.EdgeX) {
-	err = utils.GetRequest(ctx, &res, g.baseUrl, common.ApiConfigRoute, nil)
+	err = g.requester.GetRequest(ctx, &res, g.baseUrl, common.ApiConfigRoute, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -35,7 +37,7 @@ func (g *generalClient) FetchConfiguration(ctx context.Context) (res dtoCommon.C
 }
 
 func (g *generalClient) FetchMetrics(ctx context.Context) (res dtoCommon.MetricsResponse, err errors.EdgeX) {
-	err = utils.GetRequest(ctx, &res, g.baseUrl, common.ApiMetricsRoute, nil)
+	err = g.requester.GetRequest(ctx, &res, g.baseUrl, common.ApiMetricsRoute, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -21,20 +21,22 @@ import (
 )
 
 type IntervalClient struct {
-	baseUrl string
+	baseUrl   string
+	requester *utils.Requester
 }
 
 // NewIntervalClient creates an instance of IntervalClient
-func NewIntervalClient(baseUrl string) interfaces.IntervalClient {
+func NewIntervalClient(baseUrl string, opts ...utils.ClientOption) interfaces.IntervalClient {
 	return &IntervalClient{
-		baseUrl: baseUrl,
+		baseUrl:   baseUrl,
+		requester: utils.NewRequester(opts...),
 	}
 }
 
 // Add adds new intervals
 func (client IntervalClient) Add(ctx context.Context, reqs []requests.AddIntervalRequest) (
 	res []dtoCommon.BaseWithIdResponse, err errors.EdgeX) {
-	err = utils.PostRequestWithRawData(ctx, &res, client.baseUrl+common.ApiIntervalRoute, reqs)
+	err = client.requester.PostRequestWithRawData(ctx, &res, client.baseUrl+common.ApiIntervalRoute, reqs)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -44,7 +46,7 @@ func (client IntervalClient) Add(ctx context.Context, reqs []requests.AddInterva
 // Update updates intervals
 func (client IntervalClient) Update(ctx context.Context, reqs []requests.UpdateIntervalRequest) (
 	res []dtoCommon.BaseResponse, err errors.EdgeX) {
-	err = utils.PatchRequest(ctx, &res, client.baseUrl+common.ApiIntervalRoute, reqs)
+	err = client.requester.PatchRequest(ctx, &res, client.baseUrl+common.ApiIntervalRoute, reqs)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -57,7 +59,7 @@ func (client IntervalClient) AllIntervals(ctx context.Context, offset int, limit
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllIntervalRoute, requestParams)
+	err = client.requester.GetRequest(ctx, &res, client.baseUrl, common.ApiAllIntervalRoute, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -68,7 +70,7 @@ func (client IntervalClient) AllIntervals(ctx context.Context, offset int, limit
 func (client IntervalClient) IntervalByName(ctx context.Context, name string) (
 	res responses.IntervalResponse, err errors.EdgeX) {
 	path := path.Join(common.ApiIntervalRoute, common.Name, url.QueryEscape(name))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, path, nil)
+	err = client.requester.GetRequest(ctx, &res, client.baseUrl, path, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -79,7 +81,7 @@ func (client IntervalClient) IntervalByName(ctx context.Context, name string) (
 func (client IntervalClient) DeleteIntervalByName(ctx context.Context, name string) (
 	res dtoCommon.BaseResponse, err errors.EdgeX) {
 	path := path.Join(common.ApiIntervalRoute, common.Name, url.QueryEscape(name))
-	err = utils.DeleteRequest(ctx, &res, client.baseUrl, path)
+	err = client.requester.DeleteRequest(ctx, &res, client.baseUrl, path)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
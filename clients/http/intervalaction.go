@@ -21,20 +21,22 @@ import (
 )
 
 type IntervalActionClient struct {
-	baseUrl string
+	baseUrl   string
+	requester *utils.Requester
 }
 
 // NewIntervalActionClient creates an instance of IntervalActionClient
-func NewIntervalActionClient(baseUrl string) interfaces.IntervalActionClient {
+func NewIntervalActionClient(baseUrl string, opts ...utils.ClientOption) interfaces.IntervalActionClient {
 	return &IntervalActionClient{
-		baseUrl: baseUrl,
+		baseUrl:   baseUrl,
+		requester: utils.NewRequester(opts...),
 	}
 }
 
 // Add adds new intervalActions
 func (client IntervalActionClient) Add(ctx context.Context, reqs []requests.AddIntervalActionRequest) (
 	res []dtoCommon.BaseWithIdResponse, err errors.EdgeX) {
-	err = utils.PostRequestWithRawData(ctx, &res, client.baseUrl+common.ApiIntervalActionRoute, reqs)
+	err = client.requester.PostRequestWithRawData(ctx, &res, client.baseUrl+common.ApiIntervalActionRoute, reqs)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -44,7 +46,7 @@ func (client IntervalActionClient) Add(ctx context.Context, reqs []requests.AddI
 // Update updates intervalActions
 func (client IntervalActionClient) Update(ctx context.Context, reqs []requests.UpdateIntervalActionRequest) (
 	res []dtoCommon.BaseResponse, err errors.EdgeX) {
-	err = utils.PatchRequest(ctx, &res, client.baseUrl+common.ApiIntervalActionRoute, reqs)
+	err = client.requester.PatchRequest(ctx, &res, client.baseUrl+common.ApiIntervalActionRoute, reqs)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -57,7 +59,7 @@ func (client IntervalActionClient) AllIntervalActions(ctx context.Context, offse
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, common.ApiAllIntervalActionRoute, requestParams)
+	err = client.requester.GetRequest(ctx, &res, client.baseUrl, common.ApiAllIntervalActionRoute, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -68,7 +70,7 @@ func (client IntervalActionClient) AllIntervalActions(ctx context.Context, offse
 func (client IntervalActionClient) IntervalActionByName(ctx context.Context, name string) (
 	res responses.IntervalActionResponse, err errors.EdgeX) {
 	path := path.Join(common.ApiIntervalActionRoute, common.Name, url.QueryEscape(name))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, path, nil)
+	err = client.requester.GetRequest(ctx, &res, client.baseUrl, path, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -79,7 +81,7 @@ func (client IntervalActionClient) IntervalActionByName(ctx context.Context, nam
 func (client IntervalActionClient) DeleteIntervalActionByName(ctx context.Context, name string) (
 	res dtoCommon.BaseResponse, err errors.EdgeX) {
 	path := path.Join(common.ApiIntervalActionRoute, common.Name, url.QueryEscape(name))
-	err = utils.DeleteRequest(ctx, &res, client.baseUrl, path)
+	err = client.requester.DeleteRequest(ctx, &res, client.baseUrl, path)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -22,18 +22,20 @@ import (
 )
 
 type ProvisionWatcherClient struct {
-	baseUrl string
+	baseUrl   string
+	requester *utils.Requester
 }
 
 // NewProvisionWatcherClient creates an instance of ProvisionWatcherClient
-func NewProvisionWatcherClient(baseUrl string) interfaces.ProvisionWatcherClient {
+func NewProvisionWatcherClient(baseUrl string, opts ...utils.ClientOption) interfaces.ProvisionWatcherClient {
 	return &ProvisionWatcherClient{
-		baseUrl: baseUrl,
+		baseUrl:   baseUrl,
+		requester: utils.NewRequester(opts...),
 	}
 }
 
 func (pwc ProvisionWatcherClient) Add(ctx context.Context, reqs []requests.AddProvisionWatcherRequest) (res []dtoCommon.BaseWithIdResponse, err errors.EdgeX) {
-	err = utils.PostRequestWithRawData(ctx, &res, pwc.baseUrl+common.ApiProvisionWatcherRoute, reqs)
+	err = pwc.requester.PostRequestWithRawData(ctx, &res, pwc.baseUrl+common.ApiProvisionWatcherRoute, reqs)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -42,7 +44,7 @@ func (pwc ProvisionWatcherClient) Add(ctx context.Context, reqs []requests.AddPr
 }
 
 func (pwc ProvisionWatcherClient) Update(ctx context.Context, reqs []requests.UpdateProvisionWatcherRequest) (res []dtoCommon.BaseResponse, err errors.EdgeX) {
-	err = utils.PatchRequest(ctx, &res, pwc.baseUrl+common.ApiProvisionWatcherRoute, reqs)
+	err = pwc.requester.PatchRequest(ctx, &res, pwc.baseUrl+common.ApiProvisionWatcherRoute, reqs)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -57,7 +59,7 @@ func (pwc ProvisionWatcherClient) AllProvisionWatchers(ctx context.Context, labe
 	}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, pwc.baseUrl, common.ApiAllProvisionWatcherRoute, requestParams)
+	err = pwc.requester.GetRequest(ctx, &res, pwc.baseUrl, common.ApiAllProvisionWatcherRoute, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -67,7 +69,7 @@ func (pwc ProvisionWatcherClient) AllProvisionWatchers(ctx context.Context, labe
 
 func (pwc ProvisionWatcherClient) ProvisionWatcherByName(ctx context.Context, name string) (res responses.ProvisionWatcherResponse, err errors.EdgeX) {
 	path := path.Join(common.ApiProvisionWatcherRoute, common.Name, url.QueryEscape(name))
-	err = utils.GetRequest(ctx, &res, pwc.baseUrl, path, nil)
+	err = pwc.requester.GetRequest(ctx, &res, pwc.baseUrl, path, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -77,7 +79,7 @@ func (pwc ProvisionWatcherClient) ProvisionWatcherByName(ctx context.Context, na
 
 func (pwc ProvisionWatcherClient) DeleteProvisionWatcherByName(ctx context.Context, name string) (res dtoCommon.BaseResponse, err errors.EdgeX) {
 	path := path.Join(common.ApiProvisionWatcherRoute, common.Name, url.QueryEscape(name))
-	err = utils.DeleteRequest(ctx, &res, pwc.baseUrl, path)
+	err = pwc.requester.DeleteRequest(ctx, &res, pwc.baseUrl, path)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -90,7 +92,7 @@ func (pwc ProvisionWatcherClient) ProvisionWatchersByProfileName(ctx context.Con
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, pwc.baseUrl, requestPath, requestParams)
+	err = pwc.requester.GetRequest(ctx, &res, pwc.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -103,7 +105,7 @@ func (pwc ProvisionWatcherClient) ProvisionWatchersByServiceName(ctx context.Con
 	requestParams := url.Values{}
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
-	err = utils.GetRequest(ctx, &res, pwc.baseUrl, requestPath, requestParams)
+	err = pwc.requester.GetRequest(ctx, &res, pwc.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -20,13 +20,15 @@ import (
 )
 
 type readingClient struct {
-	baseUrl string
+	baseUrl   string
+	requester *utils.Requester
 }
 
 // NewReadingClient creates an instance of ReadingClient
-func NewReadingClient(baseUrl string) interfaces.ReadingClient {
+func NewReadingClient(baseUrl string, opts ...utils.ClientOption) interfaces.ReadingClient {
 	return &readingClient{
-		baseUrl: baseUrl,
+		baseUrl:   baseUrl,
+		requester: utils.NewRequester(opts...),
 	}
 }
 
@@ -35,7 +37,7 @@ func (rc readingClient) AllReadings(ctx context.Context, offset, limit int) (res
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
 	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, common.ApiAllReadingRoute, requestParams)
+	err := rc.requester.GetRequest(ctx, &res, rc.baseUrl, common.ApiAllReadingRoute, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -44,7 +46,7 @@ func (rc readingClient) AllReadings(ctx context.Context, offset, limit int) (res
 
 func (rc readingClient) ReadingCount(ctx context.Context) (dtoCommon.CountResponse, errors.EdgeX) {
 	res := dtoCommon.CountResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, common.ApiReadingCountRoute, nil)
+	err := rc.requester.GetRequest(ctx, &res, rc.baseUrl, common.ApiReadingCountRoute, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -54,7 +56,7 @@ func (rc readingClient) ReadingCount(ctx context.Context) (dtoCommon.CountRespon
 func (rc readingClient) ReadingCountByDeviceName(ctx context.Context, name string) (dtoCommon.CountResponse, errors.EdgeX) {
 	requestPath := path.Join(common.ApiReadingCountRoute, common.Device, common.Name, url.QueryEscape(name))
 	res := dtoCommon.CountResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, nil)
+	err := rc.requester.GetRequest(ctx, &res, rc.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -67,7 +69,7 @@ func (rc readingClient) ReadingsByDeviceName(ctx context.Context, name string, o
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
 	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err := rc.requester.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -80,7 +82,7 @@ func (rc readingClient) ReadingsByResourceName(ctx context.Context, name string,
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
 	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err := rc.requester.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -93,7 +95,7 @@ func (rc readingClient) ReadingsByTimeRange(ctx context.Context, start, end, off
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
 	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err := rc.requester.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -107,7 +109,7 @@ func (rc readingClient) ReadingsByResourceNameAndTimeRange(ctx context.Context,
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
 	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err := rc.requester.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -120,7 +122,7 @@ func (rc readingClient) ReadingsByDeviceNameAndResourceName(ctx context.Context,
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
 	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err := rc.requester.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -134,7 +136,7 @@ func (rc readingClient) ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx cont
 	requestParams.Set(common.Offset, strconv.Itoa(offset))
 	requestParams.Set(common.Limit, strconv.Itoa(limit))
 	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err := rc.requester.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -152,7 +154,7 @@ func (rc readingClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx con
 		queryPayload[common.ResourceNames] = resourceNames
 	}
 	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequestWithBodyRawData(ctx, &res, rc.baseUrl, requestPath, requestParams, queryPayload)
+	err := rc.requester.GetRequestWithBodyRawData(ctx, &res, rc.baseUrl, requestPath, requestParams, queryPayload)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
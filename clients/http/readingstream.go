@@ -0,0 +1,102 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"path"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const readingsField = "readings"
+
+// NewReadingStreamClient creates an instance of ReadingStreamClient
+func NewReadingStreamClient(baseUrl string, opts ...utils.ClientOption) interfaces.ReadingStreamClient {
+	return &readingClient{
+		baseUrl:   baseUrl,
+		requester: utils.NewRequester(opts...),
+	}
+}
+
+func (rc readingClient) StreamAllReadings(ctx context.Context, offset, limit int, handler interfaces.ReadingHandler) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	return rc.streamReadings(ctx, common.ApiAllReadingRoute, offset, limit, nil, handler)
+}
+
+func (rc readingClient) StreamReadingsByDeviceName(ctx context.Context, name string, offset, limit int, handler interfaces.ReadingHandler) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestPath := path.Join(common.ApiReadingRoute, common.Device, common.Name, url.QueryEscape(name))
+	return rc.streamReadings(ctx, requestPath, offset, limit, nil, handler)
+}
+
+func (rc readingClient) StreamReadingsByResourceName(ctx context.Context, name string, offset, limit int, handler interfaces.ReadingHandler) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestPath := path.Join(common.ApiReadingRoute, common.ResourceName, url.QueryEscape(name))
+	return rc.streamReadings(ctx, requestPath, offset, limit, nil, handler)
+}
+
+func (rc readingClient) StreamReadingsByTimeRange(ctx context.Context, start, end, offset, limit int, handler interfaces.ReadingHandler) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestPath := path.Join(common.ApiReadingRoute, common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	return rc.streamReadings(ctx, requestPath, offset, limit, nil, handler)
+}
+
+func (rc readingClient) StreamReadingsByResourceNameAndTimeRange(ctx context.Context, name string, start, end, offset, limit int, handler interfaces.ReadingHandler) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestPath := path.Join(common.ApiReadingRoute, common.ResourceName, url.QueryEscape(name), common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	return rc.streamReadings(ctx, requestPath, offset, limit, nil, handler)
+}
+
+func (rc readingClient) StreamReadingsByDeviceNameAndResourceName(ctx context.Context, deviceName, resourceName string, offset, limit int, handler interfaces.ReadingHandler) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestPath := path.Join(common.ApiReadingRoute, common.Device, common.Name, url.QueryEscape(deviceName), common.ResourceName, url.QueryEscape(resourceName))
+	return rc.streamReadings(ctx, requestPath, offset, limit, nil, handler)
+}
+
+func (rc readingClient) StreamReadingsByDeviceNameAndResourceNameAndTimeRange(ctx context.Context, deviceName, resourceName string, start, end, offset, limit int, handler interfaces.ReadingHandler) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestPath := path.Join(common.ApiReadingRoute, common.Device, common.Name, url.QueryEscape(deviceName), common.ResourceName, url.QueryEscape(resourceName), common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	return rc.streamReadings(ctx, requestPath, offset, limit, nil, handler)
+}
+
+func (rc readingClient) StreamReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx context.Context, deviceName string, resourceNames []string, start, end, offset, limit int, handler interfaces.ReadingHandler) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestPath := path.Join(common.ApiReadingRoute, common.Device, common.Name, url.QueryEscape(deviceName), common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	var queryPayload map[string]interface{}
+	if len(resourceNames) > 0 {
+		queryPayload = make(map[string]interface{}, 1)
+		queryPayload[common.ResourceNames] = resourceNames
+	}
+	return rc.streamReadings(ctx, requestPath, offset, limit, queryPayload, handler)
+}
+
+// streamReadings queries the readings of the requestPath and hands them over to the handler one at a time. The
+// queryPayload, if any, is sent as the request body.
+func (rc readingClient) streamReadings(ctx context.Context, requestPath string, offset, limit int, queryPayload map[string]interface{}, handler interfaces.ReadingHandler) (dtoCommon.BaseWithTotalCountResponse, errors.EdgeX) {
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	decodeItem := func(decoder *json.Decoder) error {
+		var reading dtos.BaseReading
+		if err := decoder.Decode(&reading); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the reading", err)
+		}
+		return handler(reading)
+	}
+
+	res := dtoCommon.BaseWithTotalCountResponse{}
+	var err errors.EdgeX
+	if queryPayload != nil {
+		err = rc.requester.GetRequestWithBodyRawDataAndStreamArray(ctx, &res, rc.baseUrl, requestPath, requestParams, queryPayload, readingsField, decodeItem)
+	} else {
+		err = rc.requester.GetRequestAndStreamArray(ctx, &res, rc.baseUrl, requestPath, requestParams, readingsField, decodeItem)
+	}
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
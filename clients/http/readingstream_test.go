@@ -0,0 +1,115 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+func testReadings(t *testing.T, count int) []dtos.BaseReading {
+	readings := make([]dtos.BaseReading, count)
+	for i := range readings {
+		reading, err := dtos.NewSimpleReading("profile", "device", fmt.Sprintf("resource%d", i), common.ValueTypeInt32, int32(i))
+		require.NoError(t, err)
+		readings[i] = reading
+	}
+	return readings
+}
+
+func TestStreamAllReadings(t *testing.T) {
+	readings := testReadings(t, 3)
+	ts := newTestServer(http.MethodGet, common.ApiAllReadingRoute, responses.NewMultiReadingsResponse("", "", http.StatusOK, 10, readings))
+	defer ts.Close()
+
+	client := NewReadingStreamClient(ts.URL)
+	var received []dtos.BaseReading
+	res, err := client.StreamAllReadings(context.Background(), 0, 3, func(reading dtos.BaseReading) error {
+		received = append(received, reading)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(10), res.TotalCount)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, readings, received)
+}
+
+func TestStreamReadingsByDeviceNameAndResourceNamesAndTimeRange(t *testing.T) {
+	deviceName := "device"
+	start := 1
+	end := 10
+	urlPath := path.Join(common.ApiReadingRoute, common.Device, common.Name, deviceName, common.Start, strconv.Itoa(start), common.End, strconv.Itoa(end))
+	readings := testReadings(t, 2)
+	ts := newTestServer(http.MethodGet, urlPath, responses.NewMultiReadingsResponse("", "", http.StatusOK, 2, readings))
+	defer ts.Close()
+
+	client := NewReadingStreamClient(ts.URL)
+	var received []dtos.BaseReading
+	res, err := client.StreamReadingsByDeviceNameAndResourceNamesAndTimeRange(context.Background(), deviceName, []string{"resource0", "resource1"}, start, end, 0, 10, func(reading dtos.BaseReading) error {
+		received = append(received, reading)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), res.TotalCount)
+	assert.Equal(t, readings, received)
+}
+
+func TestStreamReadingsHandlerError(t *testing.T) {
+	ts := newTestServer(http.MethodGet, common.ApiAllReadingRoute, responses.NewMultiReadingsResponse("", "", http.StatusOK, 3, testReadings(t, 3)))
+	defer ts.Close()
+
+	client := NewReadingStreamClient(ts.URL)
+	handled := 0
+	_, err := client.StreamAllReadings(context.Background(), 0, 3, func(reading dtos.BaseReading) error {
+		handled++
+		return errors.NewCommonEdgeX(errors.KindLimitExceeded, "enough readings", nil)
+	})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+	assert.Equal(t, 1, handled)
+}
+
+func TestStreamReadingsErrorResponse(t *testing.T) {
+	ts := newTestServer(http.MethodGet, common.ApiAllReadingRoute, nil)
+	defer ts.Close()
+
+	client := NewReadingStreamClient(ts.URL)
+	_, err := client.StreamReadingsByDeviceName(context.Background(), "device", 0, 10, func(reading dtos.BaseReading) error {
+		return nil
+	})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestStreamEventsByDeviceName(t *testing.T) {
+	deviceName := "device"
+	urlPath := path.Join(common.ApiEventRoute, common.Device, common.Name, deviceName)
+	events := []dtos.Event{dtos.NewEvent("profile", deviceName, "source1"), dtos.NewEvent("profile", deviceName, "source2")}
+	ts := newTestServer(http.MethodGet, urlPath, responses.NewMultiEventsResponse("", "", http.StatusOK, 2, events))
+	defer ts.Close()
+
+	client := NewEventStreamClient(ts.URL)
+	var received []dtos.Event
+	res, err := client.StreamEventsByDeviceName(context.Background(), deviceName, 0, 10, func(event dtos.Event) error {
+		received = append(received, event)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), res.TotalCount)
+	assert.Equal(t, events, received)
+}
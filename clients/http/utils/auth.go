@@ -0,0 +1,179 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+
+	// tokenExpirySkew renews the JWT a bit ahead of its expiration to absorb the clock drift and the request latency
+	tokenExpirySkew = 30 * time.Second
+	// tokenSourceTimeout bounds the call to the TokenSource, which isn't bound to the context of any single request
+	tokenSourceTimeout = 30 * time.Second
+)
+
+// WithAuthenticationInjector adds the authentication data of the specified injector to every request. If the injector
+// also implements interfaces.AuthenticationRefresher, a request rejected with 401 Unauthorized triggers a refresh of
+// the credentials and is retried once.
+func WithAuthenticationInjector(injector interfaces.AuthenticationInjector) ClientOption {
+	return func(o *clientOptions) {
+		o.authInjector = injector
+	}
+}
+
+type bearerTokenInjector struct {
+	token string
+}
+
+// NewBearerTokenInjector creates an AuthenticationInjector sending the static token as bearer token
+func NewBearerTokenInjector(token string) interfaces.AuthenticationInjector {
+	return &bearerTokenInjector{token: token}
+}
+
+func (b *bearerTokenInjector) AddAuthenticationData(req *http.Request) error {
+	req.Header.Set(authorizationHeader, bearerPrefix+b.token)
+	return nil
+}
+
+type basicAuthInjector struct {
+	username string
+	password string
+}
+
+// NewBasicAuthInjector creates an AuthenticationInjector sending the credentials with HTTP basic authentication
+func NewBasicAuthInjector(username string, password string) interfaces.AuthenticationInjector {
+	return &basicAuthInjector{username: username, password: password}
+}
+
+func (b *basicAuthInjector) AddAuthenticationData(req *http.Request) error {
+	req.SetBasicAuth(b.username, b.password)
+	return nil
+}
+
+// TokenSource obtains a new JWT, e.g. from the secret store or an identity provider
+type TokenSource func(ctx context.Context) (string, error)
+
+// JWTInjector sends the JWT obtained from a TokenSource as bearer token. The token is cached and renewed shortly
+// before the expiration time of its exp claim, or when the service rejects it with 401 Unauthorized. The TokenSource is
+// called without holding the lock of the injector and only once at a time, the requests needing a new token sharing
+// the same call while the requests with a valid token carry on.
+type JWTInjector struct {
+	source   TokenSource
+	token    string
+	expiry   time.Time
+	inflight *tokenCall
+	mutex    sync.Mutex
+}
+
+// tokenCall is a call to the TokenSource in progress, done being closed once token or err is set
+type tokenCall struct {
+	done  chan struct{}
+	token string
+	err   errors.EdgeX
+}
+
+// NewJWTInjector creates a JWTInjector obtaining the tokens from the specified source
+func NewJWTInjector(source TokenSource) *JWTInjector {
+	return &JWTInjector{source: source}
+}
+
+// AddAuthenticationData adds the cached JWT, or a new one if the cached JWT is about to expire, to the request
+func (j *JWTInjector) AddAuthenticationData(req *http.Request) error {
+	j.mutex.Lock()
+	token := j.token
+	expiring := !j.expiry.IsZero() && time.Now().Add(tokenExpirySkew).After(j.expiry)
+	j.mutex.Unlock()
+	if token == "" || expiring {
+		var err errors.EdgeX
+		if token, err = j.refresh(req.Context()); err != nil {
+			return err
+		}
+	}
+	req.Header.Set(authorizationHeader, bearerPrefix+token)
+	return nil
+}
+
+// RefreshAuthenticationData discards the cached JWT and obtains a new one from the source
+func (j *JWTInjector) RefreshAuthenticationData(ctx context.Context) error {
+	j.mutex.Lock()
+	j.token = ""
+	j.mutex.Unlock()
+	if _, err := j.refresh(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// refresh obtains a new JWT from the source, or waits for the call already in progress. The call isn't canceled with
+// the context, which only stops the caller from waiting, since the other callers may still wait for the token.
+func (j *JWTInjector) refresh(ctx context.Context) (string, errors.EdgeX) {
+	j.mutex.Lock()
+	call := j.inflight
+	if call == nil {
+		call = &tokenCall{done: make(chan struct{})}
+		j.inflight = call
+		go j.obtain(call)
+	}
+	j.mutex.Unlock()
+
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return "", errors.NewCommonEdgeX(errors.KindServerError, "failed to obtain the JWT from the token source", ctx.Err())
+	}
+}
+
+// obtain calls the source and caches the token it returns
+func (j *JWTInjector) obtain(call *tokenCall) {
+	ctx, cancel := context.WithTimeout(context.Background(), tokenSourceTimeout)
+	defer cancel()
+	token, err := j.source(ctx)
+	if err != nil {
+		call.err = errors.NewCommonEdgeX(errors.KindServerError, "failed to obtain the JWT from the token source", err)
+	}
+	j.mutex.Lock()
+	if err == nil {
+		call.token = token
+		j.token = token
+		j.expiry = jwtExpiry(token)
+	}
+	j.inflight = nil
+	j.mutex.Unlock()
+	close(call.done)
+}
+
+// jwtExpiry returns the time of the exp claim of the JWT, or the zero time if the claim can't be parsed
+func jwtExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Exp, 0)
+}
@@ -0,0 +1,183 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newAuthServer accepts only the requests carrying the expected Authorization header
+func newAuthServer(expected *atomic.Value, counter *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(counter, 1)
+		if r.Header.Get(authorizationHeader) != expected.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("{}"))
+	}))
+}
+
+func newTestJWT(expiry time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, expiry.Unix())))
+	return "header." + payload + ".signature"
+}
+
+func TestStaticAuthenticationInjectors(t *testing.T) {
+	tests := []struct {
+		name     string
+		injector ClientOption
+		expected string
+	}{
+		{"bearer token", WithAuthenticationInjector(NewBearerTokenInjector("token")), "Bearer token"},
+		{"basic auth", WithAuthenticationInjector(NewBasicAuthInjector("user", "pass")), "Basic " + base64.StdEncoding.EncodeToString([]byte("user:pass"))},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var counter int32
+			expected := &atomic.Value{}
+			expected.Store(testCase.expected)
+			ts := newAuthServer(expected, &counter)
+			defer ts.Close()
+
+			r := NewRequester(testCase.injector)
+			res := dtoCommon.BaseResponse{}
+			err := r.PostRequestWithRawData(context.Background(), &res, ts.URL, dtoCommon.NewBaseRequest())
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestJWTInjectorRefreshOnUnauthorized(t *testing.T) {
+	var counter int32
+	var issued int32
+	expected := &atomic.Value{}
+	expected.Store("Bearer token-2")
+	ts := newAuthServer(expected, &counter)
+	defer ts.Close()
+
+	injector := NewJWTInjector(func(ctx context.Context) (string, error) {
+		return fmt.Sprintf("token-%d", atomic.AddInt32(&issued, 1)), nil
+	})
+	r := NewRequester(WithAuthenticationInjector(injector))
+
+	// the first token is rejected, then the request is retried once with the refreshed token
+	res := dtoCommon.BaseResponse{}
+	err := r.PutRequest(context.Background(), &res, ts.URL, dtoCommon.NewBaseRequest())
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&counter))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&issued))
+
+	// the request is retried only once
+	expected.Store("Bearer never")
+	err = r.GetRequest(context.Background(), &res, ts.URL, "/", nil)
+	require.Error(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&counter))
+}
+
+func TestJWTInjectorRenewExpiringToken(t *testing.T) {
+	tokens := []string{newTestJWT(time.Now().Add(time.Second)), newTestJWT(time.Now().Add(time.Hour))}
+	var issued int32
+	injector := NewJWTInjector(func(ctx context.Context) (string, error) {
+		return tokens[atomic.AddInt32(&issued, 1)-1], nil
+	})
+
+	// the first token expires within the skew, so it is renewed by the next request
+	expected := []string{tokens[0], tokens[1], tokens[1]}
+	for _, token := range expected {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, injector.AddAuthenticationData(req))
+		assert.Equal(t, "Bearer "+token, req.Header.Get(authorizationHeader))
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&issued))
+}
+
+func TestJWTInjectorSharesTokenSourceCall(t *testing.T) {
+	token := newTestJWT(time.Now().Add(time.Hour))
+	release := make(chan struct{})
+	var issued int32
+	injector := NewJWTInjector(func(ctx context.Context) (string, error) {
+		atomic.AddInt32(&issued, 1)
+		<-release
+		return token, nil
+	})
+
+	// a caller giving up doesn't fail the other callers waiting for the token
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+	errs := make(chan error, 1)
+	go func() { errs <- injector.AddAuthenticationData(canceled) }()
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			assert.NoError(t, injector.AddAuthenticationData(req))
+			assert.Equal(t, "Bearer "+token, req.Header.Get(authorizationHeader))
+		}()
+	}
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&issued) == 1 }, time.Second, time.Millisecond)
+	cancel()
+	require.Error(t, <-errs)
+
+	close(release)
+	wg.Wait()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	require.NoError(t, injector.AddAuthenticationData(req))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&issued), "the callers should share a single call to the source")
+}
+
+func TestAuthenticationInjectorError(t *testing.T) {
+	var counter int32
+	ts := newFlakyServer(0, http.StatusOK, &counter)
+	defer ts.Close()
+
+	injector := &mocks.AuthenticationInjector{}
+	injector.On("AddAuthenticationData", mock.Anything).Return(errors.NewCommonEdgeX(errors.KindIOError, "no secret", nil))
+	r := NewRequester(WithAuthenticationInjector(injector))
+	res := dtoCommon.BaseResponse{}
+	err := r.GetRequest(context.Background(), &res, ts.URL, "/", nil)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindIOError, errors.Kind(err))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&counter))
+}
+
+func TestAuthenticationInjectorErrorIsNotFailedOver(t *testing.T) {
+	var counter int32
+	first := newFlakyServer(0, http.StatusOK, &counter)
+	defer first.Close()
+	second := newFlakyServer(0, http.StatusOK, &counter)
+	defer second.Close()
+
+	injector := &mocks.AuthenticationInjector{}
+	injector.On("AddAuthenticationData", mock.Anything).Return(errors.NewCommonEdgeX(errors.KindIOError, "no secret", nil))
+	lb := NewLoadBalancer([]string{first.URL, second.URL}, LoadBalancerSettings{Strategy: PrimaryFallback})
+	r := NewRequester(WithLoadBalancer(lb), WithAuthenticationInjector(injector), WithRetryPolicy(testRetryPolicy()))
+	res := dtoCommon.BaseResponse{}
+	err := r.GetRequest(context.Background(), &res, "", "/", nil)
+	require.Error(t, err)
+	injector.AssertNumberOfCalls(t, "AddAuthenticationData", 1)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&counter))
+	assert.True(t, lb.Healthy(first.URL), "a local credential failure doesn't mark the endpoint unhealthy")
+}
@@ -0,0 +1,176 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// BalancingStrategy selects the endpoint of each request among the healthy endpoints
+type BalancingStrategy string
+
+const (
+	// RoundRobin cycles through the healthy endpoints
+	RoundRobin BalancingStrategy = "RoundRobin"
+	// Random picks a healthy endpoint at random
+	Random BalancingStrategy = "Random"
+	// PrimaryFallback sends the requests to the first healthy endpoint in the order of the base URLs
+	PrimaryFallback BalancingStrategy = "PrimaryFallback"
+)
+
+const defaultCoolDown = 30 * time.Second
+
+// LoadBalancerSettings configures a LoadBalancer
+type LoadBalancerSettings struct {
+	// Strategy is the strategy selecting the endpoints. Default is RoundRobin.
+	Strategy BalancingStrategy
+	// CoolDown is how long an endpoint stays out of the selection once marked unhealthy, after which it's tried
+	// again. Default is 30s.
+	CoolDown time.Duration
+}
+
+// LoadBalancer spreads the requests across several base URLs of a service. An endpoint which can't be reached, or
+// responds with 502 Bad Gateway or 503 Service Unavailable, is passively marked unhealthy and skipped until its
+// cool-down elapses. The idempotent requests failing for these reasons are sent again to the next endpoint. When all
+// the endpoints are unhealthy, the one whose cool-down ends first is used.
+type LoadBalancer struct {
+	settings  LoadBalancerSettings
+	endpoints []*balancedEndpoint
+	next      int
+	random    *rand.Rand
+	mutex     sync.Mutex
+}
+
+type balancedEndpoint struct {
+	baseUrl        string
+	key            string
+	unhealthyUntil time.Time
+}
+
+// NewLoadBalancer creates a LoadBalancer spreading the requests across the specified base URLs
+func NewLoadBalancer(baseUrls []string, settings LoadBalancerSettings) *LoadBalancer {
+	if settings.Strategy == "" {
+		settings.Strategy = RoundRobin
+	}
+	if settings.CoolDown <= 0 {
+		settings.CoolDown = defaultCoolDown
+	}
+	endpoints := make([]*balancedEndpoint, len(baseUrls))
+	for i, baseUrl := range baseUrls {
+		endpoints[i] = &balancedEndpoint{baseUrl: baseUrl, key: baseUrlKey(baseUrl)}
+	}
+	return &LoadBalancer{
+		settings:  settings,
+		endpoints: endpoints,
+		random:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// WithLoadBalancer sends the requests to the endpoints selected by the LoadBalancer, instead of the base URL the client
+// was created with. The client can be created with an empty base URL.
+func WithLoadBalancer(balancer *LoadBalancer) ClientOption {
+	return WithEndpointResolver(balancer)
+}
+
+// ResolveEndpoint returns the base URL of the endpoint selected for the next request
+func (lb *LoadBalancer) ResolveEndpoint(_ context.Context) (string, error) {
+	lb.mutex.Lock()
+	defer lb.mutex.Unlock()
+	if len(lb.endpoints) == 0 {
+		return "", errors.NewCommonEdgeX(errors.KindServiceUnavailable, "no endpoint to balance the requests", nil)
+	}
+
+	now := time.Now()
+	healthy := make([]int, 0, len(lb.endpoints))
+	for i, endpoint := range lb.endpoints {
+		if !now.Before(endpoint.unhealthyUntil) {
+			healthy = append(healthy, i)
+		}
+	}
+	if len(healthy) == 0 {
+		return lb.soonestRecovering().baseUrl, nil
+	}
+
+	switch lb.settings.Strategy {
+	case Random:
+		return lb.endpoints[healthy[lb.random.Intn(len(healthy))]].baseUrl, nil
+	case PrimaryFallback:
+		return lb.endpoints[healthy[0]].baseUrl, nil
+	default:
+		// the first healthy endpoint at or after the position of the previous selection
+		selected := healthy[0]
+		for _, i := range healthy {
+			if i >= lb.next {
+				selected = i
+				break
+			}
+		}
+		lb.next = selected + 1
+		return lb.endpoints[selected].baseUrl, nil
+	}
+}
+
+func (lb *LoadBalancer) soonestRecovering() *balancedEndpoint {
+	soonest := lb.endpoints[0]
+	for _, endpoint := range lb.endpoints[1:] {
+		if endpoint.unhealthyUntil.Before(soonest.unhealthyUntil) {
+			soonest = endpoint
+		}
+	}
+	return soonest
+}
+
+// ReportEndpointHealth marks the endpoint of the base URL unhealthy for the cool-down, or healthy again
+func (lb *LoadBalancer) ReportEndpointHealth(baseUrl string, healthy bool) {
+	key := baseUrlKey(baseUrl)
+	lb.mutex.Lock()
+	defer lb.mutex.Unlock()
+	for _, endpoint := range lb.endpoints {
+		if endpoint.key != key {
+			continue
+		}
+		if healthy {
+			endpoint.unhealthyUntil = time.Time{}
+		} else {
+			endpoint.unhealthyUntil = time.Now().Add(lb.settings.CoolDown)
+		}
+	}
+}
+
+// EndpointCount returns the number of endpoints
+func (lb *LoadBalancer) EndpointCount() int {
+	return len(lb.endpoints)
+}
+
+// Healthy checks whether the endpoint of the base URL is currently selectable
+func (lb *LoadBalancer) Healthy(baseUrl string) bool {
+	key := baseUrlKey(baseUrl)
+	lb.mutex.Lock()
+	defer lb.mutex.Unlock()
+	for _, endpoint := range lb.endpoints {
+		if endpoint.key == key {
+			return !time.Now().Before(endpoint.unhealthyUntil)
+		}
+	}
+	return false
+}
+
+// isFailoverError checks whether the request failed because the service can't be reached at the endpoint, either
+// without response or with a KindCommunicationError or KindServiceUnavailable error. A request canceled by the caller
+// is not the fault of the endpoint.
+func isFailoverError(req *http.Request, statusCode int, err errors.EdgeX) bool {
+	if err == nil || req.Context().Err() != nil {
+		return false
+	}
+	kind := errors.Kind(err)
+	return statusCode == 0 || kind == errors.KindCommunicationError || kind == errors.KindServiceUnavailable
+}
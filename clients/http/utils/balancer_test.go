@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resolveAll(t *testing.T, lb *LoadBalancer, count int) []string {
+	baseUrls := make([]string, count)
+	for i := range baseUrls {
+		baseUrl, err := lb.ResolveEndpoint(context.Background())
+		require.NoError(t, err)
+		baseUrls[i] = baseUrl
+	}
+	return baseUrls
+}
+
+func TestLoadBalancerStrategies(t *testing.T) {
+	baseUrls := []string{"http://a:59880", "http://b:59880", "http://c:59880"}
+
+	roundRobin := NewLoadBalancer(baseUrls, LoadBalancerSettings{})
+	assert.Equal(t, []string{"http://a:59880", "http://b:59880", "http://c:59880", "http://a:59880"}, resolveAll(t, roundRobin, 4))
+	roundRobin.ReportEndpointHealth("http://b:59880/api/v2/ping", false)
+	assert.Equal(t, []string{"http://c:59880", "http://a:59880", "http://c:59880"}, resolveAll(t, roundRobin, 3))
+
+	primary := NewLoadBalancer(baseUrls, LoadBalancerSettings{Strategy: PrimaryFallback})
+	assert.Equal(t, []string{"http://a:59880", "http://a:59880"}, resolveAll(t, primary, 2))
+	primary.ReportEndpointHealth("http://a:59880", false)
+	assert.False(t, primary.Healthy("http://a:59880"))
+	assert.Equal(t, []string{"http://b:59880", "http://b:59880"}, resolveAll(t, primary, 2))
+	primary.ReportEndpointHealth("http://a:59880", true)
+	assert.Equal(t, []string{"http://a:59880"}, resolveAll(t, primary, 1))
+
+	random := NewLoadBalancer(baseUrls, LoadBalancerSettings{Strategy: Random})
+	random.ReportEndpointHealth("http://a:59880", false)
+	for _, baseUrl := range resolveAll(t, random, 20) {
+		assert.NotEqual(t, "http://a:59880", baseUrl)
+	}
+}
+
+func TestLoadBalancerCoolDown(t *testing.T) {
+	lb := NewLoadBalancer([]string{"http://a:59880", "http://b:59880"}, LoadBalancerSettings{Strategy: PrimaryFallback, CoolDown: 20 * time.Millisecond})
+	lb.ReportEndpointHealth("http://a:59880", false)
+	time.Sleep(time.Millisecond)
+	lb.ReportEndpointHealth("http://b:59880", false)
+
+	// all the endpoints are unhealthy, the one recovering first is used
+	assert.Equal(t, []string{"http://a:59880"}, resolveAll(t, lb, 1))
+
+	time.Sleep(25 * time.Millisecond)
+	assert.True(t, lb.Healthy("http://a:59880"))
+	assert.True(t, lb.Healthy("http://b:59880"))
+}
+
+func TestRequesterFailover(t *testing.T) {
+	var counter int32
+	healthy := newFlakyServer(0, http.StatusOK, &counter)
+	defer healthy.Close()
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	lb := NewLoadBalancer([]string{down.URL, unavailable.URL, healthy.URL}, LoadBalancerSettings{Strategy: PrimaryFallback})
+	r := NewRequester(WithLoadBalancer(lb))
+	res := dtoCommon.BaseResponse{}
+	require.NoError(t, r.GetRequest(context.Background(), &res, "", "/", nil))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counter))
+	assert.False(t, lb.Healthy(down.URL))
+	assert.False(t, lb.Healthy(unavailable.URL))
+	assert.True(t, lb.Healthy(healthy.URL))
+
+	// the non-idempotent requests don't fail over
+	lb = NewLoadBalancer([]string{unavailable.URL, healthy.URL}, LoadBalancerSettings{Strategy: PrimaryFallback})
+	r = NewRequester(WithLoadBalancer(lb))
+	err := r.PostRequestWithRawData(context.Background(), &res, "/", map[string]string{})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counter))
+	require.NoError(t, r.PostRequestWithRawData(context.Background(), &res, "/", map[string]string{}))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&counter))
+}
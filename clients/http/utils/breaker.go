@@ -0,0 +1,214 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// CircuitState is the state of the circuit of a base URL
+type CircuitState string
+
+const (
+	// CircuitClosed lets all the requests through
+	CircuitClosed CircuitState = "Closed"
+	// CircuitOpen rejects all the requests until the open timeout elapses
+	CircuitOpen CircuitState = "Open"
+	// CircuitHalfOpen lets a limited number of trial requests through to decide whether the service has recovered
+	CircuitHalfOpen CircuitState = "HalfOpen"
+)
+
+// breakerOutcome is the result of a request as recorded by a circuit
+type breakerOutcome int
+
+const (
+	breakerSuccess breakerOutcome = iota
+	breakerFailure
+	// breakerCanceled is a request canceled by the caller, which tells nothing about the service
+	breakerCanceled
+)
+
+const (
+	defaultFailureThreshold    = 5
+	defaultOpenTimeout         = 30 * time.Second
+	defaultHalfOpenMaxRequests = 1
+)
+
+// CircuitBreakerSettings configures a CircuitBreaker
+type CircuitBreakerSettings struct {
+	// FailureThreshold is the number of consecutive failures opening the circuit. Default is 5.
+	FailureThreshold int
+	// OpenTimeout is how long the circuit stays open before letting trial requests through. Default is 30s.
+	OpenTimeout time.Duration
+	// HalfOpenMaxRequests is the number of trial requests allowed in the half-open state, all of them must succeed to
+	// close the circuit again. Default is 1.
+	HalfOpenMaxRequests int
+	// OnStateChange, when specified, is invoked each time the circuit of a base URL changes its state.
+	OnStateChange func(baseUrl string, from CircuitState, to CircuitState)
+}
+
+// CircuitBreaker keeps one circuit per base URL, i.e. the scheme and the host of the request URL. A transport error or
+// a 5xx response counts as a failure, while a request canceled by the caller counts as neither a failure nor a
+// success. While a circuit is open, the requests fail fast with a KindServiceUnavailable error instead of waiting for
+// the unavailable service. A CircuitBreaker can be shared by several service clients.
+type CircuitBreaker struct {
+	settings CircuitBreakerSettings
+	circuits map[string]*circuit
+	mutex    sync.Mutex
+}
+
+type circuit struct {
+	state             CircuitState
+	failures          int
+	openedAt          time.Time
+	halfOpenRequests  int
+	halfOpenSuccesses int
+}
+
+// NewCircuitBreaker creates a CircuitBreaker with the specified settings
+func NewCircuitBreaker(settings CircuitBreakerSettings) *CircuitBreaker {
+	if settings.FailureThreshold <= 0 {
+		settings.FailureThreshold = defaultFailureThreshold
+	}
+	if settings.OpenTimeout <= 0 {
+		settings.OpenTimeout = defaultOpenTimeout
+	}
+	if settings.HalfOpenMaxRequests <= 0 {
+		settings.HalfOpenMaxRequests = defaultHalfOpenMaxRequests
+	}
+	return &CircuitBreaker{
+		settings: settings,
+		circuits: make(map[string]*circuit),
+	}
+}
+
+// WithCircuitBreaker guards the requests with the specified CircuitBreaker
+func WithCircuitBreaker(breaker *CircuitBreaker) ClientOption {
+	return func(o *clientOptions) {
+		o.circuitBreaker = breaker
+	}
+}
+
+// State returns the current state of the circuit for the specified base URL
+func (cb *CircuitBreaker) State(baseUrl string) CircuitState {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	c, ok := cb.circuits[baseUrlKey(baseUrl)]
+	if !ok {
+		return CircuitClosed
+	}
+	if c.state == CircuitOpen && time.Since(c.openedAt) >= cb.settings.OpenTimeout {
+		return CircuitHalfOpen
+	}
+	return c.state
+}
+
+// allow checks whether the request can be sent. The returned function must be invoked with the outcome of the request.
+func (cb *CircuitBreaker) allow(req *http.Request) (func(outcome breakerOutcome), errors.EdgeX) {
+	key := baseUrlKey(req.URL.String())
+
+	cb.mutex.Lock()
+	c, ok := cb.circuits[key]
+	if !ok {
+		c = &circuit{state: CircuitClosed}
+		cb.circuits[key] = c
+	}
+	var transition func()
+	if c.state == CircuitOpen && time.Since(c.openedAt) >= cb.settings.OpenTimeout {
+		transition = cb.setState(key, c, CircuitHalfOpen)
+	}
+	if c.state == CircuitOpen || (c.state == CircuitHalfOpen && c.halfOpenRequests >= cb.settings.HalfOpenMaxRequests) {
+		cb.mutex.Unlock()
+		notify(transition)
+		return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("circuit breaker is open for %s", key), nil)
+	}
+	halfOpen := c.state == CircuitHalfOpen
+	if halfOpen {
+		c.halfOpenRequests++
+	}
+	cb.mutex.Unlock()
+	notify(transition)
+
+	return func(outcome breakerOutcome) {
+		cb.record(key, c, halfOpen, outcome)
+	}, nil
+}
+
+// record updates the circuit with the outcome of a request. A canceled request is recorded neither as a success nor as
+// a failure, it only releases its trial slot if the circuit is still half-open.
+func (cb *CircuitBreaker) record(key string, c *circuit, halfOpen bool, outcome breakerOutcome) {
+	cb.mutex.Lock()
+	var transition func()
+	failed := outcome == breakerFailure
+	switch {
+	case outcome == breakerCanceled:
+		if halfOpen && c.state == CircuitHalfOpen {
+			c.halfOpenRequests--
+		}
+	case halfOpen && c.state == CircuitHalfOpen:
+		if failed {
+			transition = cb.setState(key, c, CircuitOpen)
+			break
+		}
+		c.halfOpenSuccesses++
+		if c.halfOpenSuccesses >= cb.settings.HalfOpenMaxRequests {
+			transition = cb.setState(key, c, CircuitClosed)
+		}
+	case c.state == CircuitClosed:
+		if !failed {
+			c.failures = 0
+			break
+		}
+		c.failures++
+		if c.failures >= cb.settings.FailureThreshold {
+			transition = cb.setState(key, c, CircuitOpen)
+		}
+	}
+	cb.mutex.Unlock()
+	notify(transition)
+}
+
+// setState changes the state of the circuit and returns the notification to invoke once the mutex is released
+func (cb *CircuitBreaker) setState(key string, c *circuit, state CircuitState) func() {
+	from := c.state
+	c.state = state
+	c.failures = 0
+	c.halfOpenRequests = 0
+	c.halfOpenSuccesses = 0
+	if state == CircuitOpen {
+		c.openedAt = time.Now()
+	}
+	if cb.settings.OnStateChange == nil {
+		return nil
+	}
+	return func() {
+		cb.settings.OnStateChange(key, from, state)
+	}
+}
+
+func notify(transition func()) {
+	if transition != nil {
+		transition()
+	}
+}
+
+// outcomeOf returns the outcome of a request for the circuit breaker: a failure if the result indicates the service is
+// unavailable, unless the request was canceled by the caller, which is not the fault of the service.
+func outcomeOf(req *http.Request, statusCode int) breakerOutcome {
+	switch {
+	case statusCode == 0 && req.Context().Err() != nil:
+		return breakerCanceled
+	case statusCode == 0, statusCode >= http.StatusInternalServerError && statusCode != http.StatusNotImplemented:
+		return breakerFailure
+	default:
+		return breakerSuccess
+	}
+}
@@ -0,0 +1,144 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var counter int32
+	var healthy int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&counter, 1)
+		if atomic.LoadInt32(&healthy) == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer ts.Close()
+
+	var mutex sync.Mutex
+	var transitions []CircuitState
+	breaker := NewCircuitBreaker(CircuitBreakerSettings{
+		FailureThreshold: 2,
+		OpenTimeout:      50 * time.Millisecond,
+		OnStateChange: func(baseUrl string, from CircuitState, to CircuitState) {
+			assert.Equal(t, ts.URL, baseUrl)
+			mutex.Lock()
+			transitions = append(transitions, to)
+			mutex.Unlock()
+		},
+	})
+	r := NewRequester(WithCircuitBreaker(breaker))
+	get := func() errors.EdgeX {
+		res := dtoCommon.BaseResponse{}
+		return r.GetRequest(context.Background(), &res, ts.URL, "/", nil)
+	}
+
+	// the consecutive failures open the circuit
+	require.Error(t, get())
+	assert.Equal(t, CircuitClosed, breaker.State(ts.URL))
+	require.Error(t, get())
+	assert.Equal(t, CircuitOpen, breaker.State(ts.URL))
+
+	// the open circuit fails fast without reaching the service
+	err := get()
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&counter))
+
+	// a failed trial request opens the circuit again
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, CircuitHalfOpen, breaker.State(ts.URL))
+	require.Error(t, get())
+	assert.Equal(t, CircuitOpen, breaker.State(ts.URL))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&counter))
+
+	// a successful trial request closes the circuit
+	atomic.StoreInt32(&healthy, 1)
+	time.Sleep(60 * time.Millisecond)
+	require.NoError(t, get())
+	assert.Equal(t, CircuitClosed, breaker.State(ts.URL))
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, []CircuitState{CircuitOpen, CircuitHalfOpen, CircuitOpen, CircuitHalfOpen, CircuitClosed}, transitions)
+}
+
+func TestCircuitBreakerIgnoresClientErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	breaker := NewCircuitBreaker(CircuitBreakerSettings{FailureThreshold: 1})
+	r := NewRequester(WithCircuitBreaker(breaker))
+	for i := 0; i < 3; i++ {
+		res := dtoCommon.BaseResponse{}
+		err := r.GetRequest(context.Background(), &res, ts.URL, "/", nil)
+		require.Error(t, err)
+		assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	}
+	assert.Equal(t, CircuitClosed, breaker.State(ts.URL))
+}
+
+func TestCircuitBreakerIgnoresCanceledRequests(t *testing.T) {
+	var hang int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&hang) == 1 {
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	breaker := NewCircuitBreaker(CircuitBreakerSettings{FailureThreshold: 2, OpenTimeout: 50 * time.Millisecond})
+	r := NewRequester(WithCircuitBreaker(breaker))
+	get := func() errors.EdgeX {
+		res := dtoCommon.BaseResponse{}
+		return r.GetRequest(context.Background(), &res, ts.URL, "/", nil)
+	}
+	getCanceled := func() {
+		atomic.StoreInt32(&hang, 1)
+		defer atomic.StoreInt32(&hang, 0)
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		res := dtoCommon.BaseResponse{}
+		require.Error(t, r.GetRequest(ctx, &res, ts.URL, "/", nil))
+	}
+
+	// a canceled request doesn't reset the consecutive failures
+	require.Error(t, get())
+	getCanceled()
+	assert.Equal(t, CircuitClosed, breaker.State(ts.URL))
+	require.Error(t, get())
+	assert.Equal(t, CircuitOpen, breaker.State(ts.URL))
+
+	// a canceled trial request neither closes the circuit nor keeps its trial slot
+	time.Sleep(60 * time.Millisecond)
+	getCanceled()
+	assert.Equal(t, CircuitHalfOpen, breaker.State(ts.URL))
+	err := get()
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "circuit breaker is open", "the trial slot should be released")
+	assert.Equal(t, CircuitOpen, breaker.State(ts.URL))
+}
@@ -17,14 +17,15 @@ import (
 	"net/url"
 	"path/filepath"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/requestcontext"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
-
-	"github.com/google/uuid"
 )
 
 // FromContext allows for the retrieval of the specified key's value from the supplied Context.
 // If the value is not found, an empty string is returned.
+// The values stored with string keys can collide with other packages, use the typed keys of the requestcontext and
+// tracecontext packages instead.
 func FromContext(ctx context.Context, key string) string {
 	hdr, ok := ctx.Value(key).(string)
 	if !ok {
@@ -33,16 +34,6 @@ func FromContext(ctx context.Context, key string) string {
 	return hdr
 }
 
-// correlatedId gets Correlation ID from supplied context. If no Correlation ID header is
-// present in the supplied context, one will be created along with a value.
-func correlatedId(ctx context.Context) string {
-	correlation := FromContext(ctx, common.CorrelationHeader)
-	if len(correlation) == 0 {
-		correlation = uuid.New().String()
-	}
-	return correlation
-}
-
 // Helper method to get the body from the response after making the request
 func getBody(resp *http.Response) ([]byte, errors.EdgeX) {
 	body, err := ioutil.ReadAll(resp.Body)
@@ -52,42 +43,31 @@ func getBody(resp *http.Response) ([]byte, errors.EdgeX) {
 	return body, nil
 }
 
-// Helper method to make the request and return the response
-func makeRequest(req *http.Request) (*http.Response, errors.EdgeX) {
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to send a http request", err)
-	}
-	if resp == nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "the response should not be a nil", nil)
-	}
-	return resp, nil
-}
-
 func createRequest(ctx context.Context, httpMethod string, baseUrl string, requestPath string, requestParams url.Values) (*http.Request, errors.EdgeX) {
-	u, err := url.Parse(baseUrl)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "fail to parse baseUrl", err)
+	u, edgexErr := parseBaseUrl(baseUrl)
+	if edgexErr != nil {
+		return nil, edgexErr
 	}
 	u.Path = requestPath
+	u.RawPath = ""
 	if requestParams != nil {
 		u.RawQuery = requestParams.Encode()
 	}
-	req, err := http.NewRequest(httpMethod, u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, httpMethod, u.String(), nil)
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
-	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
+	requestcontext.Propagate(ctx, req.Header)
 	return req, nil
 }
 
 func createRequestWithRawDataAndParams(ctx context.Context, httpMethod string, baseUrl string, requestPath string, requestParams url.Values, data interface{}) (*http.Request, errors.EdgeX) {
-	u, err := url.Parse(baseUrl)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindServerError, "fail to parse baseUrl", err)
+	u, edgexErr := parseBaseUrl(baseUrl)
+	if edgexErr != nil {
+		return nil, edgexErr
 	}
 	u.Path = requestPath
+	u.RawPath = ""
 	if requestParams != nil {
 		u.RawQuery = requestParams.Encode()
 	}
@@ -101,16 +81,21 @@ func createRequestWithRawDataAndParams(ctx context.Context, httpMethod string, b
 		content = common.ContentTypeJSON
 	}
 
-	req, err := http.NewRequest(httpMethod, u.String(), bytes.NewReader(jsonEncodedData))
+	req, err := http.NewRequestWithContext(ctx, httpMethod, u.String(), bytes.NewReader(jsonEncodedData))
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
 	req.Header.Set(common.ContentType, content)
-	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
+	requestcontext.Propagate(ctx, req.Header)
 	return req, nil
 }
 
 func createRequestWithRawData(ctx context.Context, httpMethod string, url string, data interface{}) (*http.Request, errors.EdgeX) {
+	url, edgexErr := requestUrl(url)
+	if edgexErr != nil {
+		return nil, edgexErr
+	}
+
 	jsonEncodedData, err := json.Marshal(data)
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode input data to JSON", err)
@@ -121,32 +106,42 @@ func createRequestWithRawData(ctx context.Context, httpMethod string, url string
 		content = common.ContentTypeJSON
 	}
 
-	req, err := http.NewRequest(httpMethod, url, bytes.NewReader(jsonEncodedData))
+	req, err := http.NewRequestWithContext(ctx, httpMethod, url, bytes.NewReader(jsonEncodedData))
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
 	req.Header.Set(common.ContentType, content)
-	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
+	requestcontext.Propagate(ctx, req.Header)
 	return req, nil
 }
 
 func createRequestWithEncodedData(ctx context.Context, httpMethod string, url string, data []byte, encoding string) (*http.Request, errors.EdgeX) {
+	url, edgexErr := requestUrl(url)
+	if edgexErr != nil {
+		return nil, edgexErr
+	}
+
 	content := encoding
 	if content == "" {
 		content = FromContext(ctx, common.ContentType)
 	}
 
-	req, err := http.NewRequest(httpMethod, url, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(ctx, httpMethod, url, bytes.NewReader(data))
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
 	req.Header.Set(common.ContentType, content)
-	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
+	requestcontext.Propagate(ctx, req.Header)
 	return req, nil
 }
 
 // createRequestFromFilePath creates multipart/form-data request with the specified file
 func createRequestFromFilePath(ctx context.Context, httpMethod string, url string, filePath string) (*http.Request, errors.EdgeX) {
+	url, edgexErr := requestUrl(url)
+	if edgexErr != nil {
+		return nil, edgexErr
+	}
+
 	fileContents, err := ioutil.ReadFile(filePath)
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("fail to read file from %s", filePath), err)
@@ -164,35 +159,25 @@ func createRequestFromFilePath(ctx context.Context, httpMethod string, url strin
 	}
 	writer.Close()
 
-	req, err := http.NewRequest(httpMethod, url, body)
+	req, err := http.NewRequestWithContext(ctx, httpMethod, url, body)
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create a http request", err)
 	}
 	req.Header.Set(common.ContentType, writer.FormDataContentType())
-	req.Header.Set(common.CorrelationHeader, correlatedId(ctx))
+	requestcontext.Propagate(ctx, req.Header)
 	return req, nil
 }
 
-// sendRequest will make a request with raw data to the specified URL.
-// It returns the body as a byte array if successful and an error otherwise.
-func sendRequest(ctx context.Context, req *http.Request) ([]byte, errors.EdgeX) {
-	resp, err := makeRequest(req)
-	if err != nil {
-		return nil, errors.NewCommonEdgeXWrapper(err)
+// baseUrlKey returns the scheme and the host of the URL, which identifies the service the request is sent to. The
+// requests sent through a Unix domain socket are identified by the base URL of the unix scheme, e.g.
+// unix:///run/edgex/core-metadata.sock.
+func baseUrlKey(rawUrl string) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" {
+		return rawUrl
 	}
-	defer resp.Body.Close()
-
-	bodyBytes, err := getBody(resp)
-	if err != nil {
-		return nil, errors.NewCommonEdgeXWrapper(err)
+	if socketPath, ok := unixSocketPath(u.Hostname()); ok {
+		return UnixSocketScheme + "://" + socketPath
 	}
-
-	if resp.StatusCode <= http.StatusMultiStatus {
-		return bodyBytes, nil
-	}
-
-	// Handle error response
-	msg := fmt.Sprintf("request failed, status code: %d, err: %s", resp.StatusCode, string(bodyBytes))
-	errKind := errors.KindMapping(resp.StatusCode)
-	return nil, errors.NewCommonEdgeX(errKind, msg, nil)
+	return u.Scheme + "://" + u.Host
 }
@@ -0,0 +1,49 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/requestcontext"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/tracecontext"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRequestPropagatesHeaders(t *testing.T) {
+	inbound := http.Header{}
+	inbound.Set(tracecontext.TraceParentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	inbound.Set(tracecontext.TraceStateHeader, "vendor=value")
+	ctx := tracecontext.Extract(context.Background(), inbound)
+	ctx = context.WithValue(ctx, common.CorrelationHeader, "correlation-id")
+
+	req, err := createRequest(ctx, http.MethodGet, "http://localhost:59880", common.ApiAllEventRoute, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "correlation-id", req.Header.Get(common.CorrelationHeader))
+	outbound, ok := tracecontext.Parse(req.Header.Get(tracecontext.TraceParentHeader), "")
+	require.True(t, ok)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", outbound.TraceId)
+	assert.NotEqual(t, "00f067aa0ba902b7", outbound.SpanId, "the request is sent within a child span")
+	assert.Equal(t, "vendor=value", req.Header.Get(tracecontext.TraceStateHeader))
+}
+
+func TestCreateRequestPropagatesRequestMetadata(t *testing.T) {
+	ctx := requestcontext.WithCorrelationId(context.Background(), "correlation-id")
+	ctx = requestcontext.WithTenant(ctx, "tenant")
+	ctx = requestcontext.WithForwardedHeaders(ctx, http.Header{"X-Forwarded": {"forwarded"}, "Content-Type": {"text/plain"}})
+
+	req, err := createRequestWithRawData(ctx, http.MethodPost, "http://localhost:59880"+common.ApiEventRoute, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "correlation-id", req.Header.Get(common.CorrelationHeader))
+	assert.Equal(t, "tenant", req.Header.Get(common.TenantHeader))
+	assert.Equal(t, "forwarded", req.Header.Get("X-Forwarded"))
+	assert.Equal(t, common.ContentTypeJSON, req.Header.Get(common.ContentType), "a forwarded header doesn't override the client's")
+}
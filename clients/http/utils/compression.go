@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const (
+	contentEncodingHeader = "Content-Encoding"
+	acceptEncodingHeader  = "Accept-Encoding"
+	gzipEncoding          = "gzip"
+	deflateEncoding       = "deflate"
+)
+
+// CompressionSettings configures the compression of the request and the response bodies
+type CompressionSettings struct {
+	// RequestThreshold is the size in bytes from which the request bodies are compressed with gzip, whatever their
+	// encoding, e.g. JSON or CBOR. Zero disables the compression of the requests.
+	RequestThreshold int
+	// Level is the gzip compression level of the requests, from gzip.HuffmanOnly to gzip.BestCompression. Default is
+	// gzip.DefaultCompression.
+	Level int
+	// AcceptCompressedResponses asks the service for gzip or deflate compressed responses. The compressed responses
+	// are decompressed transparently.
+	AcceptCompressedResponses bool
+}
+
+// WithCompression compresses the request bodies and asks for compressed responses according to the settings
+func WithCompression(settings CompressionSettings) ClientOption {
+	return func(o *clientOptions) {
+		o.compression = &settings
+	}
+}
+
+// compressRequest compresses the body of the request with gzip when it reaches the threshold, and asks for a
+// compressed response if configured. The body is compressed once, the compressed body being rewound on retries.
+func (cs *CompressionSettings) compressRequest(req *http.Request) errors.EdgeX {
+	if cs.AcceptCompressedResponses && req.Header.Get(acceptEncodingHeader) == "" {
+		req.Header.Set(acceptEncodingHeader, gzipEncoding+", "+deflateEncoding)
+	}
+	if cs.RequestThreshold <= 0 || req.GetBody == nil || req.ContentLength < int64(cs.RequestThreshold) ||
+		req.Header.Get(contentEncodingHeader) != "" {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to read the request body", err)
+	}
+	defer body.Close()
+
+	level := cs.Level
+	if level == 0 {
+		level = gzip.DefaultCompression
+	}
+	compressed := &bytes.Buffer{}
+	writer, err := gzip.NewWriterLevel(compressed, level)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid gzip compression level", err)
+	}
+	if _, err = io.Copy(writer, body); err != nil {
+		return errors.NewCommonEdgeX(errors.KindIOError, "failed to compress the request body", err)
+	}
+	if err = writer.Close(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindIOError, "failed to compress the request body", err)
+	}
+
+	data := compressed.Bytes()
+	req.Body = ioutil.NopCloser(bytes.NewReader(data))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}
+	req.ContentLength = int64(len(data))
+	req.Header.Set(contentEncodingHeader, gzipEncoding)
+	return nil
+}
+
+// decompressResponse replaces the body of a gzip or deflate compressed response with the decompressed body
+func decompressResponse(resp *http.Response) errors.EdgeX {
+	var reader io.ReadCloser
+	var err error
+	switch strings.ToLower(strings.TrimSpace(resp.Header.Get(contentEncodingHeader))) {
+	case gzipEncoding:
+		reader, err = gzip.NewReader(resp.Body)
+	case deflateEncoding:
+		reader, err = zlib.NewReader(resp.Body)
+	default:
+		return nil
+	}
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindIOError, "failed to decompress the response body", err)
+	}
+
+	resp.Body = &decompressedBody{reader: reader, body: resp.Body}
+	resp.Header.Del(contentEncodingHeader)
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// decompressedBody reads the decompressed body of a response, closing it also closes the underlying response body
+type decompressedBody struct {
+	reader io.ReadCloser
+	body   io.ReadCloser
+}
+
+func (db *decompressedBody) Read(p []byte) (int, error) {
+	return db.reader.Read(p)
+}
+
+func (db *decompressedBody) Close() error {
+	db.reader.Close()
+	return db.body.Close()
+}
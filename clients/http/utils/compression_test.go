@@ -0,0 +1,145 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCompressionServer echoes the hex encoded decompressed request body in the message of the response, which is compressed with
+// the first encoding accepted by the client
+func newCompressionServer(t *testing.T, requestEncodings *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requestEncodings = append(*requestEncodings, r.Header.Get(contentEncodingHeader))
+		var body io.Reader = r.Body
+		if r.Header.Get(contentEncodingHeader) == gzipEncoding {
+			reader, err := gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			body = reader
+		}
+		data, err := ioutil.ReadAll(body)
+		require.NoError(t, err)
+		res, _ := json.Marshal(dtoCommon.NewBaseResponse("", hex.EncodeToString(data), http.StatusOK))
+
+		var writer io.WriteCloser
+		switch strings.Split(r.Header.Get(acceptEncodingHeader), ",")[0] {
+		case gzipEncoding:
+			w.Header().Set(contentEncodingHeader, gzipEncoding)
+			writer = gzip.NewWriter(w)
+		case deflateEncoding:
+			w.Header().Set(contentEncodingHeader, deflateEncoding)
+			writer = zlib.NewWriter(w)
+		default:
+			_, _ = w.Write(res)
+			return
+		}
+		_, _ = writer.Write(res)
+		_ = writer.Close()
+	}))
+}
+
+func TestRequesterWithCompression(t *testing.T) {
+	var requestEncodings []string
+	ts := newCompressionServer(t, &requestEncodings)
+	defer ts.Close()
+
+	r := NewRequester(WithCompression(CompressionSettings{RequestThreshold: 64, AcceptCompressedResponses: true}))
+	small := map[string]string{"name": "small"}
+	large := map[string]string{"name": strings.Repeat("large", 100)}
+
+	res := dtoCommon.BaseResponse{}
+	require.NoError(t, r.PostRequestWithRawData(context.Background(), &res, ts.URL, small))
+	expected, _ := json.Marshal(small)
+	assert.Equal(t, hex.EncodeToString(expected), res.Message)
+	require.NoError(t, r.PostRequestWithRawData(context.Background(), &res, ts.URL, large))
+	expected, _ = json.Marshal(large)
+	assert.Equal(t, hex.EncodeToString(expected), res.Message)
+
+	cborData, err := cbor.Marshal(large)
+	require.NoError(t, err)
+	require.NoError(t, r.PostRequest(context.Background(), &res, ts.URL, cborData, common.ContentTypeCBOR))
+	assert.Equal(t, hex.EncodeToString(cborData), res.Message)
+
+	assert.Equal(t, []string{"", gzipEncoding, gzipEncoding}, requestEncodings)
+}
+
+func TestRequesterDecompressesResponses(t *testing.T) {
+	var requestEncodings []string
+	ts := newCompressionServer(t, &requestEncodings)
+	defer ts.Close()
+
+	for _, encoding := range []string{gzipEncoding, deflateEncoding} {
+		t.Run(encoding, func(t *testing.T) {
+			r := NewRequester(WithInterceptors(func(req *http.Request, next Invoker) (*http.Response, error) {
+				req.Header.Set(acceptEncodingHeader, encoding)
+				resp, err := next(req)
+				if err == nil {
+					assert.Equal(t, encoding, resp.Header.Get(contentEncodingHeader))
+				}
+				return resp, err
+			}))
+			res := dtoCommon.BaseResponse{}
+			require.NoError(t, r.GetRequestWithBodyRawData(context.Background(), &res, ts.URL, "/", nil, "test"))
+			assert.Equal(t, hex.EncodeToString([]byte(`"test"`)), res.Message)
+		})
+	}
+}
+
+func TestCompressRequestRewind(t *testing.T) {
+	req, err := createRequestWithEncodedData(context.Background(), http.MethodPost, "http://localhost", bytes.Repeat([]byte("a"), 100), common.ContentTypeCBOR)
+	require.NoError(t, err)
+	settings := CompressionSettings{RequestThreshold: 10, Level: gzip.BestCompression}
+	require.NoError(t, settings.compressRequest(req))
+	assert.Equal(t, gzipEncoding, req.Header.Get(contentEncodingHeader))
+	assert.Less(t, req.ContentLength, int64(100))
+
+	// the compressed body is not compressed again and can be rewound
+	require.NoError(t, settings.compressRequest(req))
+	require.NoError(t, rewindBody(req))
+	reader, readErr := gzip.NewReader(req.Body)
+	require.NoError(t, readErr)
+	data, readErr := ioutil.ReadAll(reader)
+	require.NoError(t, readErr)
+	assert.Equal(t, bytes.Repeat([]byte("a"), 100), data)
+
+	settings.Level = 42
+	req, err = createRequestWithEncodedData(context.Background(), http.MethodPost, "http://localhost", bytes.Repeat([]byte("a"), 100), common.ContentTypeCBOR)
+	require.NoError(t, err)
+	assert.Error(t, settings.compressRequest(req))
+}
+
+func TestCompressedRequestWithRequestIdsIsRetried(t *testing.T) {
+	var counter int32
+	ts := newFlakyServer(1, http.StatusServiceUnavailable, &counter)
+	defer ts.Close()
+
+	policy := testRetryPolicy()
+	policy.RetryDeduplicatedRequests = true
+	r := NewRequester(WithRetryPolicy(policy), WithCompression(CompressionSettings{RequestThreshold: 1}))
+	data := []dtoCommon.BaseRequest{dtoCommon.NewBaseRequest(), dtoCommon.NewBaseRequest()}
+	var res []dtoCommon.BaseWithIdResponse
+	_ = r.PostRequestWithRawData(context.Background(), &res, ts.URL, data)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&counter))
+}
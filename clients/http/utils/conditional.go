@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const (
+	ifNoneMatchHeader     = "If-None-Match"
+	ifModifiedSinceHeader = "If-Modified-Since"
+)
+
+type conditionalGetKey struct{}
+
+// ConditionalGet holds the validators making a GET request conditional
+type ConditionalGet struct {
+	// ETag is the value of the If-None-Match header, omitted if empty
+	ETag string
+	// ModifiedSince is the time of the If-Modified-Since header, omitted if zero
+	ModifiedSince time.Time
+}
+
+// WithConditionalGet returns a copy of the context making the GET and HEAD requests sent with it by the Requester
+// conditional on the validators. Unlike the forwarded headers of the requestcontext package, the validators aren't
+// propagated to the services down the line, nor set on the requests of the other methods. A 304 Not Modified response
+// is returned as an errors.ResponseError with the 304 status code.
+func WithConditionalGet(ctx context.Context, conditions ConditionalGet) context.Context {
+	return context.WithValue(ctx, conditionalGetKey{}, conditions)
+}
+
+// ConditionalGetFromContext returns the validators carried by the context, if any
+func ConditionalGetFromContext(ctx context.Context) (ConditionalGet, bool) {
+	conditions, ok := ctx.Value(conditionalGetKey{}).(ConditionalGet)
+	return conditions, ok
+}
+
+// setConditions sets the validators carried by the context on a GET or HEAD request
+func setConditions(ctx context.Context, req *http.Request) {
+	conditions, ok := ConditionalGetFromContext(ctx)
+	if !ok || (req.Method != http.MethodGet && req.Method != http.MethodHead) {
+		return
+	}
+	if conditions.ETag != "" {
+		req.Header.Set(ifNoneMatchHeader, conditions.ETag)
+	}
+	if !conditions.ModifiedSince.IsZero() {
+		req.Header.Set(ifModifiedSinceHeader, conditions.ModifiedSince.UTC().Format(http.TimeFormat))
+	}
+}
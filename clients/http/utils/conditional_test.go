@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionalGet(t *testing.T) {
+	var headers []http.Header
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+		if r.Method == http.MethodGet && r.Header.Get(ifNoneMatchHeader) == `"1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer ts.Close()
+
+	ctx := WithConditionalGet(context.Background(), ConditionalGet{ETag: `"1"`, ModifiedSince: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)})
+	r := NewRequester()
+	res := dtoCommon.BaseResponse{}
+	err := r.GetRequest(ctx, &res, ts.URL, "/", nil)
+	require.Error(t, err)
+	responseErr, ok := errors.AsResponseError(err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusNotModified, responseErr.StatusCode)
+
+	// the validators are neither set on the requests of the other methods nor set without context
+	require.NoError(t, r.PostRequestWithRawData(ctx, &res, ts.URL, map[string]string{}))
+	require.NoError(t, r.GetRequest(context.Background(), &res, ts.URL, "/", nil))
+
+	require.Len(t, headers, 3)
+	assert.Equal(t, `"1"`, headers[0].Get(ifNoneMatchHeader))
+	assert.Equal(t, "Fri, 01 Jan 2021 00:00:00 GMT", headers[0].Get(ifModifiedSinceHeader))
+	for _, header := range headers[1:] {
+		assert.Empty(t, header.Get(ifNoneMatchHeader))
+		assert.Empty(t, header.Get(ifModifiedSinceHeader))
+	}
+}
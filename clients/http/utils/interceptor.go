@@ -0,0 +1,101 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+)
+
+// Invoker sends the request to the next interceptor of the chain, or to the service at the end of the chain
+type Invoker func(req *http.Request) (*http.Response, error)
+
+// Interceptor intercepts each attempt of a request. An interceptor can modify the request before invoking next, inspect
+// or replace the response returned by next, or short-circuit the chain by returning a response or an error without
+// invoking next. An error of type errors.EdgeX keeps its kind, any other error is reported as KindServerError.
+type Interceptor func(req *http.Request, next Invoker) (*http.Response, error)
+
+var (
+	globalInterceptors      []Interceptor
+	globalInterceptorsMutex sync.RWMutex
+)
+
+// AddGlobalInterceptors appends the interceptors to the chain of every Requester of the process, including the ones
+// already created. The global interceptors run before the interceptors of the Requester.
+func AddGlobalInterceptors(interceptors ...Interceptor) {
+	globalInterceptorsMutex.Lock()
+	defer globalInterceptorsMutex.Unlock()
+	globalInterceptors = append(globalInterceptors, interceptors...)
+}
+
+// ResetGlobalInterceptors removes all the global interceptors
+func ResetGlobalInterceptors() {
+	globalInterceptorsMutex.Lock()
+	defer globalInterceptorsMutex.Unlock()
+	globalInterceptors = nil
+}
+
+// WithInterceptors appends the interceptors to the chain of the Requester. The interceptors run in the order they are
+// specified, each attempt of a retried request goes through the whole chain.
+func WithInterceptors(interceptors ...Interceptor) ClientOption {
+	return func(o *clientOptions) {
+		o.interceptors = append(o.interceptors, interceptors...)
+	}
+}
+
+// chainInterceptors builds the invoker running the global interceptors, then the specified ones and finally the invoker
+func chainInterceptors(invoker Invoker, interceptors []Interceptor) Invoker {
+	globalInterceptorsMutex.RLock()
+	chain := make([]Interceptor, 0, len(globalInterceptors)+len(interceptors))
+	chain = append(chain, globalInterceptors...)
+	globalInterceptorsMutex.RUnlock()
+	chain = append(chain, interceptors...)
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		interceptor, next := chain[i], invoker
+		invoker = func(req *http.Request) (*http.Response, error) {
+			return interceptor(req, next)
+		}
+	}
+	return invoker
+}
+
+// NewLoggingInterceptor creates an Interceptor logging each request along with its status code and latency. The
+// requests are logged at the DEBUG severity level, the ones failing without response at the ERROR severity level.
+func NewLoggingInterceptor(lc logger.LoggingClient) Interceptor {
+	return func(req *http.Request, next Invoker) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next(req)
+		latency := time.Since(start)
+		if err != nil {
+			lc.Errorf("%s %s failed after %v: %v", req.Method, req.URL.Redacted(), latency, err)
+			return resp, err
+		}
+		lc.Debugf("%s %s returned %d in %v", req.Method, req.URL.Redacted(), resp.StatusCode, latency)
+		return resp, nil
+	}
+}
+
+// LatencyRecorder records the latency of a request. The status code is 0 if no response is received.
+type LatencyRecorder func(req *http.Request, statusCode int, latency time.Duration)
+
+// NewLatencyInterceptor creates an Interceptor measuring the time until the response header is received and handing it
+// over to the recorder, e.g. to update a timer metric.
+func NewLatencyInterceptor(record LatencyRecorder) Interceptor {
+	return func(req *http.Request, next Invoker) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next(req)
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		record(req, statusCode, time.Since(start))
+		return resp, err
+	}
+}
@@ -0,0 +1,131 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingLogger keeps the formatted DEBUG and ERROR messages
+type recordingLogger struct {
+	logger.MockLogger
+	debugs []string
+	errors []string
+}
+
+func (l *recordingLogger) Debugf(msg string, _ ...interface{}) {
+	l.debugs = append(l.debugs, msg)
+}
+
+func (l *recordingLogger) Errorf(msg string, _ ...interface{}) {
+	l.errors = append(l.errors, msg)
+}
+
+func headerInterceptor(name string, value string) Interceptor {
+	return func(req *http.Request, next Invoker) (*http.Response, error) {
+		req.Header.Add(name, value)
+		return next(req)
+	}
+}
+
+func TestInterceptorChainOrder(t *testing.T) {
+	var counter int32
+	ts := newFlakyServer(0, http.StatusOK, &counter)
+	defer ts.Close()
+
+	var seen []string
+	r := NewRequester(
+		WithInterceptors(headerInterceptor("X-Chain", "first"), headerInterceptor("X-Chain", "second")),
+		WithInterceptors(func(req *http.Request, next Invoker) (*http.Response, error) {
+			seen = req.Header.Values("X-Chain")
+			return next(req)
+		}),
+	)
+	// the global interceptors also apply to the Requesters created before they are added
+	AddGlobalInterceptors(headerInterceptor("X-Chain", "global"))
+	defer ResetGlobalInterceptors()
+
+	res := dtoCommon.BaseResponse{}
+	err := r.GetRequest(context.Background(), &res, ts.URL, "/", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"global", "first", "second"}, seen)
+}
+
+func TestInterceptorShortCircuit(t *testing.T) {
+	var counter int32
+	ts := newFlakyServer(0, http.StatusOK, &counter)
+	defer ts.Close()
+
+	cached := WithInterceptors(func(req *http.Request, next Invoker) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"message":"cached"}`)),
+			Request:    req,
+		}, nil
+	})
+	res := dtoCommon.BaseResponse{}
+	err := NewRequester(cached).GetRequest(context.Background(), &res, ts.URL, "/", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "cached", res.Message)
+
+	faulty := WithInterceptors(func(req *http.Request, next Invoker) (*http.Response, error) {
+		return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "injected fault", nil)
+	})
+	err = NewRequester(faulty).GetRequest(context.Background(), &res, ts.URL, "/", nil)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&counter))
+}
+
+func TestInterceptorSeesEachAttempt(t *testing.T) {
+	var counter int32
+	ts := newFlakyServer(2, http.StatusServiceUnavailable, &counter)
+	defer ts.Close()
+
+	var statusCodes []int
+	latency := NewLatencyInterceptor(func(req *http.Request, statusCode int, latency time.Duration) {
+		statusCodes = append(statusCodes, statusCode)
+		assert.True(t, latency > 0)
+	})
+	r := NewRequester(WithRetryPolicy(testRetryPolicy()), WithInterceptors(latency))
+	res := dtoCommon.BaseResponse{}
+	err := r.GetRequest(context.Background(), &res, ts.URL, "/", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK}, statusCodes)
+}
+
+func TestLoggingInterceptor(t *testing.T) {
+	var counter int32
+	ts := newFlakyServer(0, http.StatusOK, &counter)
+
+	lc := &recordingLogger{}
+	r := NewRequester(WithInterceptors(NewLoggingInterceptor(lc)))
+	res := dtoCommon.BaseResponse{}
+	err := r.GetRequest(context.Background(), &res, ts.URL, "/", nil)
+	require.NoError(t, err)
+	assert.Len(t, lc.debugs, 1)
+	assert.Empty(t, lc.errors)
+
+	ts.Close()
+	err = r.GetRequest(context.Background(), &res, ts.URL, "/", nil)
+	require.Error(t, err)
+	assert.Len(t, lc.debugs, 1)
+	assert.Len(t, lc.errors, 1)
+}
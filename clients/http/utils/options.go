@@ -0,0 +1,178 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const (
+	defaultMaxIdleConns        = 100
+	defaultMaxIdleConnsPerHost = 100
+	defaultIdleConnTimeout     = 90 * time.Second
+)
+
+// sharedHttpClient is used by every Requester created without transport related options, so that all the service
+// clients of a process share one keep-alive connection pool.
+var sharedHttpClient = &http.Client{Transport: newTransport(clientOptions{})}
+
+// ClientOption configures the Requester used by a service client.
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	httpClient          *http.Client
+	transport           http.RoundTripper
+	timeout             time.Duration
+	tlsConfig           *tls.Config
+	proxy               func(*http.Request) (*url.URL, error)
+	maxIdleConnsPerHost int
+	idleConnTimeout     time.Duration
+	retryPolicy         *RetryPolicy
+	circuitBreaker      *CircuitBreaker
+	authInjector        interfaces.AuthenticationInjector
+	interceptors        []Interceptor
+	rateLimiter         *RateLimiter
+	endpointResolver    interfaces.EndpointResolver
+	compression         *CompressionSettings
+}
+
+// WithHTTPClient makes the Requester send the requests through the specified http.Client. The transport related
+// options (TLS, proxy and connection pooling) are ignored when a http.Client is injected.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(o *clientOptions) {
+		o.httpClient = client
+	}
+}
+
+// WithTransport makes the Requester send the requests through the specified http.RoundTripper. The transport related
+// options (TLS, proxy and connection pooling) are ignored when a http.RoundTripper is injected.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(o *clientOptions) {
+		o.transport = transport
+	}
+}
+
+// WithTimeout sets the default timeout of each request. The timeout only applies when the request context doesn't
+// carry a deadline of its own.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithTLSConfig sets the TLS configuration, e.g. the trusted CA and the client certificate for mutual TLS.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(o *clientOptions) {
+		o.tlsConfig = config
+	}
+}
+
+// WithProxy sends the requests through the specified proxy instead of the one defined by the environment variables.
+func WithProxy(proxyUrl *url.URL) ClientOption {
+	return func(o *clientOptions) {
+		o.proxy = http.ProxyURL(proxyUrl)
+	}
+}
+
+// WithKeepAlive sets the number of idle keep-alive connections kept per host and how long they stay in the pool.
+func WithKeepAlive(maxIdleConnsPerHost int, idleConnTimeout time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.maxIdleConnsPerHost = maxIdleConnsPerHost
+		o.idleConnTimeout = idleConnTimeout
+	}
+}
+
+// WithEndpointResolver sends the requests to the base URL returned by the resolver, instead of the base URL the client
+// was created with. Only the scheme and the host of the request URL are replaced, the client can be created with an
+// empty base URL.
+func WithEndpointResolver(resolver interfaces.EndpointResolver) ClientOption {
+	return func(o *clientOptions) {
+		o.endpointResolver = resolver
+	}
+}
+
+// buildHttpClient returns the http.Client described by the options, falling back to the shared one when no transport
+// related option is set.
+func (o clientOptions) buildHttpClient() *http.Client {
+	if o.httpClient != nil {
+		return o.httpClient
+	}
+	if o.transport != nil {
+		return &http.Client{Transport: o.transport}
+	}
+	if o.tlsConfig == nil && o.proxy == nil && o.maxIdleConnsPerHost == 0 && o.idleConnTimeout == 0 {
+		return sharedHttpClient
+	}
+	return &http.Client{Transport: newTransport(o)}
+}
+
+func newTransport(o clientOptions) *http.Transport {
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: NewUnixSocketDialContext((&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext),
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          defaultMaxIdleConns,
+		MaxIdleConnsPerHost:   defaultMaxIdleConnsPerHost,
+		IdleConnTimeout:       defaultIdleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	if o.proxy != nil {
+		transport.Proxy = o.proxy
+	}
+	transport.Proxy = skipProxyForUnixSocket(transport.Proxy)
+	if o.maxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = o.maxIdleConnsPerHost
+		if o.maxIdleConnsPerHost > transport.MaxIdleConns {
+			transport.MaxIdleConns = o.maxIdleConnsPerHost
+		}
+	}
+	if o.idleConnTimeout > 0 {
+		transport.IdleConnTimeout = o.idleConnTimeout
+	}
+	if o.tlsConfig != nil {
+		transport.TLSClientConfig = o.tlsConfig
+	}
+	return transport
+}
+
+// NewTLSConfig creates the TLS configuration trusting the CA certificate of caCertPath. When both certPath and keyPath
+// are specified, the key pair is presented as client certificate for mutual TLS.
+func NewTLSConfig(caCertPath string, certPath string, keyPath string) (*tls.Config, errors.EdgeX) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caCertPath != "" {
+		caCert, err := ioutil.ReadFile(caCertPath)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("fail to read CA certificate from %s", caCertPath), err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no valid PEM certificate found in %s", caCertPath), nil)
+		}
+		config.RootCAs = pool
+	}
+	if certPath != "" && keyPath != "" {
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindIOError, "fail to load the client certificate", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
@@ -0,0 +1,166 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// RateLimit configures a token bucket
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the bucket is refilled. Zero means unlimited.
+	RequestsPerSecond float64
+	// Burst is the capacity of the bucket, i.e. the number of requests that can be sent at once. Default is the
+	// rate rounded up, with a minimum of 1.
+	Burst int
+}
+
+func (rl RateLimit) withDefaults() RateLimit {
+	if rl.Burst <= 0 {
+		rl.Burst = int(math.Max(1, math.Ceil(rl.RequestsPerSecond)))
+	}
+	return rl
+}
+
+// RateLimiterSettings configures a RateLimiter
+type RateLimiterSettings struct {
+	// Default is the limit of each base URL without a limit of its own in PerBaseUrl
+	Default RateLimit
+	// PerBaseUrl holds the limits of specific base URLs, keyed by the scheme and the host, e.g. http://localhost:59882
+	PerBaseUrl map[string]RateLimit
+	// PerMethod holds the limits of the HTTP methods, e.g. http.MethodPut, applying to each base URL on top of the
+	// limit of the base URL
+	PerMethod map[string]RateLimit
+	// FailFast makes the requests exceeding the limit fail immediately instead of waiting for the bucket to refill
+	FailFast bool
+}
+
+// RateLimiter throttles the requests with one token bucket per base URL, and one per base URL and HTTP method for the
+// methods having a limit of their own. A request waits until the buckets are refilled, unless the wait would exceed
+// the deadline of the request context or FailFast is set, in which case the request fails with a KindLimitExceeded
+// error. A RateLimiter can be shared by several service clients.
+type RateLimiter struct {
+	settings RateLimiterSettings
+	buckets  map[string]*tokenBucket
+	mutex    sync.Mutex
+}
+
+// NewRateLimiter creates a RateLimiter with the specified settings
+func NewRateLimiter(settings RateLimiterSettings) *RateLimiter {
+	return &RateLimiter{
+		settings: settings,
+		buckets:  make(map[string]*tokenBucket),
+	}
+}
+
+// WithRateLimiter throttles the requests with the specified RateLimiter
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(o *clientOptions) {
+		o.rateLimiter = limiter
+	}
+}
+
+// wait takes a token from each bucket the request is subject to, waiting for the buckets to refill if needed
+func (rl *RateLimiter) wait(req *http.Request) errors.EdgeX {
+	key := baseUrlKey(req.URL.String())
+	now := time.Now()
+
+	rl.mutex.Lock()
+	var buckets []*tokenBucket
+	limit, ok := rl.settings.PerBaseUrl[key]
+	if !ok {
+		limit = rl.settings.Default
+	}
+	if bucket := rl.bucket(key, limit, now); bucket != nil {
+		buckets = append(buckets, bucket)
+	}
+	if limit, ok := rl.settings.PerMethod[req.Method]; ok {
+		if bucket := rl.bucket(req.Method+" "+key, limit, now); bucket != nil {
+			buckets = append(buckets, bucket)
+		}
+	}
+
+	var delay time.Duration
+	for _, bucket := range buckets {
+		if wait := bucket.reserve(now); wait > delay {
+			delay = wait
+		}
+	}
+	deadline, hasDeadline := req.Context().Deadline()
+	if delay > 0 && (rl.settings.FailFast || (hasDeadline && now.Add(delay).After(deadline))) {
+		for _, bucket := range buckets {
+			bucket.cancel()
+		}
+		rl.mutex.Unlock()
+		return errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("rate limit exceeded for %s %s", req.Method, key), nil)
+	}
+	rl.mutex.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		rl.mutex.Lock()
+		for _, bucket := range buckets {
+			bucket.cancel()
+		}
+		rl.mutex.Unlock()
+		return errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("rate limit exceeded for %s %s", req.Method, key), req.Context().Err())
+	}
+}
+
+// bucket returns the bucket of the key, creating it if needed, or nil if the limit is unlimited. The mutex must be
+// held by the caller.
+func (rl *RateLimiter) bucket(key string, limit RateLimit, now time.Time) *tokenBucket {
+	if limit.RequestsPerSecond <= 0 {
+		return nil
+	}
+	bucket, ok := rl.buckets[key]
+	if !ok {
+		limit = limit.withDefaults()
+		bucket = &tokenBucket{rate: limit.RequestsPerSecond, capacity: float64(limit.Burst), tokens: float64(limit.Burst), last: now}
+		rl.buckets[key] = bucket
+	}
+	return bucket
+}
+
+// tokenBucket is refilled continuously at rate tokens per second up to its capacity. The tokens can go negative, the
+// debt being the reservations of the requests waiting for the bucket to refill.
+type tokenBucket struct {
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+// reserve takes a token and returns how long to wait until the token is actually available
+func (tb *tokenBucket) reserve(now time.Time) time.Duration {
+	if elapsed := now.Sub(tb.last); elapsed > 0 {
+		tb.tokens = math.Min(tb.capacity, tb.tokens+elapsed.Seconds()*tb.rate)
+		tb.last = now
+	}
+	tb.tokens--
+	if tb.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-tb.tokens / tb.rate * float64(time.Second))
+}
+
+// cancel gives back the token of a reservation that won't be used
+func (tb *tokenBucket) cancel() {
+	tb.tokens = math.Min(tb.capacity, tb.tokens+1)
+}
@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterFailFast(t *testing.T) {
+	var counter int32
+	ts := newFlakyServer(0, http.StatusOK, &counter)
+	defer ts.Close()
+
+	limiter := NewRateLimiter(RateLimiterSettings{Default: RateLimit{RequestsPerSecond: 1, Burst: 2}, FailFast: true})
+	r := NewRequester(WithRateLimiter(limiter))
+	res := dtoCommon.BaseResponse{}
+	require.NoError(t, r.GetRequest(context.Background(), &res, ts.URL, "/", nil))
+	require.NoError(t, r.GetRequest(context.Background(), &res, ts.URL, "/", nil))
+	err := r.GetRequest(context.Background(), &res, ts.URL, "/", nil)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&counter))
+}
+
+func TestRateLimiterBlocks(t *testing.T) {
+	var counter int32
+	ts := newFlakyServer(0, http.StatusOK, &counter)
+	defer ts.Close()
+
+	limiter := NewRateLimiter(RateLimiterSettings{Default: RateLimit{RequestsPerSecond: 20, Burst: 1}})
+	r := NewRequester(WithRateLimiter(limiter))
+	res := dtoCommon.BaseResponse{}
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, r.GetRequest(context.Background(), &res, ts.URL, "/", nil))
+	}
+	// the 2nd and 3rd requests wait 50ms each for the bucket to refill
+	assert.True(t, time.Since(start) >= 90*time.Millisecond)
+
+	// the wait would exceed the deadline of the request
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.NoError(t, r.GetRequest(context.Background(), &res, ts.URL, "/", nil))
+	err := r.GetRequest(ctx, &res, ts.URL, "/", nil)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+}
+
+func TestRateLimiterPerBaseUrlAndMethod(t *testing.T) {
+	var counter int32
+	ts := newFlakyServer(0, http.StatusOK, &counter)
+	defer ts.Close()
+	other := newFlakyServer(0, http.StatusOK, &counter)
+	defer other.Close()
+
+	limiter := NewRateLimiter(RateLimiterSettings{
+		PerBaseUrl: map[string]RateLimit{ts.URL: {RequestsPerSecond: 1, Burst: 2}},
+		PerMethod:  map[string]RateLimit{http.MethodPut: {RequestsPerSecond: 1}},
+		FailFast:   true,
+	})
+	r := NewRequester(WithRateLimiter(limiter))
+	res := dtoCommon.BaseResponse{}
+
+	// the PUT requests are limited to 1 per base URL on top of the limit of the base URL
+	require.NoError(t, r.PutRequest(context.Background(), &res, ts.URL+"/", nil))
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(r.PutRequest(context.Background(), &res, ts.URL+"/", nil)))
+	require.NoError(t, r.GetRequest(context.Background(), &res, ts.URL, "/", nil))
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(r.GetRequest(context.Background(), &res, ts.URL, "/", nil)))
+
+	// the other base URL has no limit except for the PUT requests
+	require.NoError(t, r.PutRequest(context.Background(), &res, other.URL+"/", nil))
+	for i := 0; i < 5; i++ {
+		require.NoError(t, r.GetRequest(context.Background(), &res, other.URL, "/", nil))
+	}
+}
@@ -0,0 +1,434 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// defaultRequester is used by the package level request functions
+var defaultRequester = NewRequester()
+
+// Requester sends the requests built by this package through one http.Client, so connections are pooled and reused
+// across calls. A Requester is safe for concurrent use and can be shared by several service clients.
+type Requester struct {
+	httpClient   *http.Client
+	timeout      time.Duration
+	retryPolicy  *RetryPolicy
+	breaker      *CircuitBreaker
+	authInjector interfaces.AuthenticationInjector
+	interceptors []Interceptor
+	rateLimiter  *RateLimiter
+	resolver     interfaces.EndpointResolver
+	compression  *CompressionSettings
+}
+
+// NewRequester creates a Requester with the specified options. Without any transport related option, the Requester
+// uses the http.Client shared by the whole process.
+func NewRequester(opts ...ClientOption) *Requester {
+	o := clientOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Requester{
+		httpClient:   o.buildHttpClient(),
+		timeout:      o.timeout,
+		retryPolicy:  o.retryPolicy,
+		breaker:      o.circuitBreaker,
+		authInjector: o.authInjector,
+		interceptors: o.interceptors,
+		rateLimiter:  o.rateLimiter,
+		resolver:     o.endpointResolver,
+		compression:  o.compression,
+	}
+}
+
+// Helper method to make the request through the interceptor chain and return the response
+func (r *Requester) makeRequest(req *http.Request) (*http.Response, errors.EdgeX) {
+	resp, err := chainInterceptors(r.httpClient.Do, r.interceptors)(req)
+	if err != nil {
+		if edgexErr, ok := err.(errors.EdgeX); ok {
+			return nil, errors.NewCommonEdgeX(errors.Kind(edgexErr), "failed to send a http request", edgexErr)
+		}
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to send a http request", err)
+	}
+	if resp == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "the response should not be a nil", nil)
+	}
+	return resp, nil
+}
+
+// send makes the request within the default timeout, retrying it according to the retry policy or failing over to
+// another endpoint of the FailoverEndpointResolver, and returns the body and the header of a successful response.
+// Each attempt is throttled by the rate limiter and guarded by the circuit breaker if any, and carries the
+// authentication data of the injector if any. The body of the request is compressed once up front according to the
+// compression settings if any, and a GET request is made conditional by the validators of WithConditionalGet if any.
+// When stream is specified, the body of a successful response is handed over to stream
+// instead of being read into memory.
+func (r *Requester) send(ctx context.Context, req *http.Request, stream func(io.Reader) errors.EdgeX) ([]byte, http.Header, errors.EdgeX) {
+	if _, ok := ctx.Deadline(); !ok && r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	setConditions(ctx, req)
+	// the request is inspected for requestIds before its body is compressed
+	retryable := r.retryPolicy != nil && r.retryPolicy.isRetryableRequest(req)
+	failovers := r.maxFailovers(req)
+	if r.compression != nil {
+		if err := r.compression.compressRequest(req); err != nil {
+			return nil, nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	refreshed := false
+	attempt := 1
+	for {
+		body, header, statusCode, err := r.attempt(req, stream)
+		if err == nil {
+			return body, header, nil
+		}
+
+		// The service can't be reached at the resolved endpoint, send the request again to the next endpoint
+		if failovers > 0 && isFailoverError(req, statusCode, err) {
+			failovers--
+			if rewindBody(req) == nil {
+				continue
+			}
+		}
+
+		// The credentials might have expired, refresh them and retry once without backoff
+		if statusCode == http.StatusUnauthorized && !refreshed {
+			refreshed = true
+			if r.refreshAuthentication(ctx) && rewindBody(req) == nil {
+				continue
+			}
+		}
+
+		if !retryable || attempt >= r.retryPolicy.MaxAttempts || !isRetryableStatus(statusCode) {
+			return nil, nil, err
+		}
+
+		timer := time.NewTimer(r.retryPolicy.backoff(attempt, parseRetryAfter(header)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, nil, err
+		case <-timer.C:
+		}
+
+		if err := rewindBody(req); err != nil {
+			return nil, nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		attempt++
+	}
+}
+
+// attempt makes a single attempt of the request sent to the resolved endpoint, throttled by the rate limiter and
+// guarded by the circuit breaker. The header and the status code of the response are also returned along with the
+// error, so that the caller can decide whether to retry.
+func (r *Requester) attempt(req *http.Request, stream func(io.Reader) errors.EdgeX) ([]byte, http.Header, int, errors.EdgeX) {
+	if r.resolver != nil {
+		if err := r.resolveEndpoint(req); err != nil {
+			return nil, nil, 0, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if r.rateLimiter != nil {
+		if err := r.rateLimiter.wait(req); err != nil {
+			return nil, nil, -1, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if r.authInjector != nil {
+		if err := r.authInjector.AddAuthenticationData(req); err != nil {
+			// a local failure, which must neither be failed over nor retried as a transport error
+			return nil, nil, -1, errors.NewCommonEdgeX(errors.Kind(err), "failed to add the authentication data to the request", err)
+		}
+	}
+
+	var done func(outcome breakerOutcome)
+	if r.breaker != nil {
+		var err errors.EdgeX
+		if done, err = r.breaker.allow(req); err != nil {
+			// the endpoint is known to be unavailable, let a FailoverEndpointResolver pick another one
+			if reporter, ok := r.resolver.(interfaces.FailoverEndpointResolver); ok {
+				reporter.ReportEndpointHealth(req.URL.String(), false)
+			}
+			return nil, nil, -1, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	body, header, statusCode, err := r.sendOnce(req, stream)
+	if done != nil {
+		done(outcomeOf(req, statusCode))
+	}
+	if reporter, ok := r.resolver.(interfaces.FailoverEndpointResolver); ok && req.Context().Err() == nil {
+		reporter.ReportEndpointHealth(req.URL.String(), !isFailoverError(req, statusCode, err))
+	}
+	return body, header, statusCode, err
+}
+
+// maxFailovers returns how many times the request can be sent again to another endpoint, i.e. once per other endpoint
+// of a FailoverEndpointResolver if the request can be sent again without side effects.
+func (r *Requester) maxFailovers(req *http.Request) int {
+	resolver, ok := r.resolver.(interfaces.FailoverEndpointResolver)
+	if !ok {
+		return 0
+	}
+	policy := RetryPolicy{}
+	if r.retryPolicy != nil {
+		policy = *r.retryPolicy
+	}
+	if !policy.isRetryableRequest(req) {
+		return 0
+	}
+	return resolver.EndpointCount() - 1
+}
+
+// resolveEndpoint replaces the scheme and the host of the request URL with the ones of the base URL returned by the
+// endpoint resolver, so that each attempt is sent to the current endpoint of the service.
+func (r *Requester) resolveEndpoint(req *http.Request) errors.EdgeX {
+	baseUrl, err := r.resolver.ResolveEndpoint(req.Context())
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "failed to resolve the endpoint of the service", err)
+	}
+	u, edgexErr := parseBaseUrl(baseUrl)
+	if edgexErr != nil || u.Host == "" {
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("invalid endpoint %s resolved for the service", baseUrl), edgexErr)
+	}
+	req.URL.Scheme = u.Scheme
+	req.URL.Host = u.Host
+	req.Host = ""
+	return nil
+}
+
+// refreshAuthentication refreshes the credentials of the injector, it returns false if the credentials can't be
+// refreshed.
+func (r *Requester) refreshAuthentication(ctx context.Context) bool {
+	refresher, ok := r.authInjector.(interfaces.AuthenticationRefresher)
+	if !ok {
+		return false
+	}
+	return refresher.RefreshAuthenticationData(ctx) == nil
+}
+
+// rewindBody resets the body of the request so that it can be sent again
+func rewindBody(req *http.Request) errors.EdgeX {
+	if req.GetBody == nil {
+		if req.Body == nil || req.Body == http.NoBody {
+			return nil
+		}
+		return errors.NewCommonEdgeX(errors.KindServerError, "the request body can't be rewound", nil)
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to rewind the request body", err)
+	}
+	req.Body = body
+	return nil
+}
+
+// sendOnce makes a single attempt of the request. The header and the status code of the response are also returned
+// along with the error, so that the caller can decide whether to retry. The status code is 0 if no response is received.
+func (r *Requester) sendOnce(req *http.Request, stream func(io.Reader) errors.EdgeX) ([]byte, http.Header, int, errors.EdgeX) {
+	resp, err := r.makeRequest(req)
+	if err != nil {
+		return nil, nil, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	defer resp.Body.Close()
+	if err := decompressResponse(resp); err != nil {
+		return nil, resp.Header, resp.StatusCode, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	if stream != nil && resp.StatusCode <= http.StatusMultiStatus {
+		if err := stream(resp.Body); err != nil {
+			return nil, resp.Header, resp.StatusCode, errors.NewCommonEdgeXWrapper(err)
+		}
+		return nil, resp.Header, resp.StatusCode, nil
+	}
+
+	bodyBytes, err := getBody(resp)
+	if err != nil {
+		return nil, nil, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	if resp.StatusCode <= http.StatusMultiStatus {
+		return bodyBytes, resp.Header, resp.StatusCode, nil
+	}
+
+	// Handle error response
+	return nil, resp.Header, resp.StatusCode, newResponseError(resp.StatusCode, bodyBytes)
+}
+
+// sendRequest will make a request with raw data to the specified URL.
+// It returns the body as a byte array if successful and an error otherwise.
+func (r *Requester) sendRequest(ctx context.Context, req *http.Request) ([]byte, errors.EdgeX) {
+	body, _, err := r.send(ctx, req, nil)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return body, nil
+}
+
+// sendRequestAndParseResponse makes the request and unmarshals the JSON response to the returnValuePointer
+func (r *Requester) sendRequestAndParseResponse(ctx context.Context, req *http.Request, returnValuePointer interface{}) errors.EdgeX {
+	res, err := r.sendRequest(ctx, req)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := json.Unmarshal(res, returnValuePointer); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+	}
+	return nil
+}
+
+// GetRequest makes the get request and return the body
+func (r *Requester) GetRequest(ctx context.Context, returnValuePointer interface{}, baseUrl string, requestPath string, requestParams url.Values) errors.EdgeX {
+	req, err := createRequest(ctx, http.MethodGet, baseUrl, requestPath, requestParams)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	res, err := r.sendRequest(ctx, req)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	// Check the response content length to avoid json unmarshal error
+	if len(res) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(res, returnValuePointer); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+	}
+	return nil
+}
+
+// GetRequestAndReturnBinaryRes makes the get request and return the binary response and content type(i.e., application/json, application/cbor, ... )
+func (r *Requester) GetRequestAndReturnBinaryRes(ctx context.Context, baseUrl string, requestPath string, requestParams url.Values) (res []byte, contentType string, edgeXerr errors.EdgeX) {
+	req, edgeXerr := createRequest(ctx, http.MethodGet, baseUrl, requestPath, requestParams)
+	if edgeXerr != nil {
+		return nil, "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	res, header, edgeXerr := r.send(ctx, req, nil)
+	if edgeXerr != nil {
+		return nil, "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return res, header.Get(common.ContentType), nil
+}
+
+// GetRequestWithBodyRawData makes the GET request with JSON raw data as request body and return the response
+func (r *Requester) GetRequestWithBodyRawData(ctx context.Context, returnValuePointer interface{}, baseUrl string, requestPath string, requestParams url.Values, data interface{}) errors.EdgeX {
+	req, err := createRequestWithRawDataAndParams(ctx, http.MethodGet, baseUrl, requestPath, requestParams, data)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return r.sendRequestAndParseResponse(ctx, req, returnValuePointer)
+}
+
+// PostRequest makes the post request with encoded data and return the body
+func (r *Requester) PostRequest(ctx context.Context, returnValuePointer interface{}, url string, data []byte, encoding string) errors.EdgeX {
+	req, err := createRequestWithEncodedData(ctx, http.MethodPost, url, data, encoding)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return r.sendRequestAndParseResponse(ctx, req, returnValuePointer)
+}
+
+// PostRequestWithRawData makes the post JSON request with raw data and return the body
+func (r *Requester) PostRequestWithRawData(ctx context.Context, returnValuePointer interface{}, url string, data interface{}) errors.EdgeX {
+	req, err := createRequestWithRawData(ctx, http.MethodPost, url, data)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return r.sendRequestAndParseResponse(ctx, req, returnValuePointer)
+}
+
+// PutRequest makes the put JSON request and return the body
+func (r *Requester) PutRequest(ctx context.Context, returnValuePointer interface{}, url string, data interface{}) errors.EdgeX {
+	req, err := createRequestWithRawData(ctx, http.MethodPut, url, data)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return r.sendRequestAndParseResponse(ctx, req, returnValuePointer)
+}
+
+// PatchRequest makes a PATCH request and unmarshals the response to the returnValuePointer
+func (r *Requester) PatchRequest(ctx context.Context, returnValuePointer interface{}, url string, data interface{}) errors.EdgeX {
+	req, err := createRequestWithRawData(ctx, http.MethodPatch, url, data)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return r.sendRequestAndParseResponse(ctx, req, returnValuePointer)
+}
+
+// PostByFileRequest makes the post file request and return the body
+func (r *Requester) PostByFileRequest(ctx context.Context, returnValuePointer interface{}, url string, filePath string) errors.EdgeX {
+	req, err := createRequestFromFilePath(ctx, http.MethodPost, url, filePath)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return r.sendRequestAndParseResponse(ctx, req, returnValuePointer)
+}
+
+// PutByFileRequest makes the put file request and return the body
+func (r *Requester) PutByFileRequest(ctx context.Context, returnValuePointer interface{}, url string, filePath string) errors.EdgeX {
+	req, err := createRequestFromFilePath(ctx, http.MethodPut, url, filePath)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return r.sendRequestAndParseResponse(ctx, req, returnValuePointer)
+}
+
+// DeleteRequest makes the delete request and return the body
+func (r *Requester) DeleteRequest(ctx context.Context, returnValuePointer interface{}, baseUrl string, requestPath string) errors.EdgeX {
+	req, err := createRequest(ctx, http.MethodDelete, baseUrl, requestPath, nil)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return r.sendRequestAndParseResponse(ctx, req, returnValuePointer)
+}
+
+// GetRequestAndStreamArray makes the get request and decodes the items of the arrayField of the JSON response one at a
+// time with decodeItem, so the memory usage doesn't grow with the size of the array. The other fields of the response
+// are unmarshalled to the returnValuePointer.
+func (r *Requester) GetRequestAndStreamArray(ctx context.Context, returnValuePointer interface{}, baseUrl string, requestPath string, requestParams url.Values, arrayField string, decodeItem func(*json.Decoder) error) errors.EdgeX {
+	req, err := createRequest(ctx, http.MethodGet, baseUrl, requestPath, requestParams)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return r.sendRequestAndStreamArray(ctx, req, returnValuePointer, arrayField, decodeItem)
+}
+
+// GetRequestWithBodyRawDataAndStreamArray makes the GET request with JSON raw data as request body and decodes the
+// items of the arrayField of the JSON response one at a time with decodeItem. The other fields of the response are
+// unmarshalled to the returnValuePointer.
+func (r *Requester) GetRequestWithBodyRawDataAndStreamArray(ctx context.Context, returnValuePointer interface{}, baseUrl string, requestPath string, requestParams url.Values, data interface{}, arrayField string, decodeItem func(*json.Decoder) error) errors.EdgeX {
+	req, err := createRequestWithRawDataAndParams(ctx, http.MethodGet, baseUrl, requestPath, requestParams, data)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return r.sendRequestAndStreamArray(ctx, req, returnValuePointer, arrayField, decodeItem)
+}
+
+func (r *Requester) sendRequestAndStreamArray(ctx context.Context, req *http.Request, returnValuePointer interface{}, arrayField string, decodeItem func(*json.Decoder) error) errors.EdgeX {
+	_, _, err := r.send(ctx, req, func(body io.Reader) errors.EdgeX {
+		return decodeArrayField(body, returnValuePointer, arrayField, decodeItem)
+	})
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
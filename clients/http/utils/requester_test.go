@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingTransport struct {
+	count int32
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&t.count, 1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestNewRequester(t *testing.T) {
+	injected := &http.Client{}
+	transport := &countingTransport{}
+
+	tests := []struct {
+		name          string
+		opts          []ClientOption
+		expectShared  bool
+		expectClient  *http.Client
+		expectTimeout time.Duration
+	}{
+		{"default", nil, true, nil, 0},
+		{"timeout only", []ClientOption{WithTimeout(time.Second)}, true, nil, time.Second},
+		{"injected http client", []ClientOption{WithHTTPClient(injected)}, false, injected, 0},
+		{"injected transport", []ClientOption{WithTransport(transport)}, false, nil, 0},
+		{"keep alive", []ClientOption{WithKeepAlive(10, time.Minute)}, false, nil, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := NewRequester(testCase.opts...)
+			assert.Equal(t, testCase.expectShared, r.httpClient == sharedHttpClient)
+			if testCase.expectClient != nil {
+				assert.Same(t, testCase.expectClient, r.httpClient)
+			}
+			assert.Equal(t, testCase.expectTimeout, r.timeout)
+		})
+	}
+}
+
+func TestRequesterWithTransport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer ts.Close()
+
+	transport := &countingTransport{}
+	r := NewRequester(WithTransport(transport))
+	for i := 0; i < 3; i++ {
+		res := dtoCommon.BaseResponse{}
+		err := r.GetRequest(context.Background(), &res, ts.URL, "/", nil)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&transport.count))
+}
+
+func TestRequesterWithTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	r := NewRequester(WithTimeout(50 * time.Millisecond))
+	res := dtoCommon.BaseResponse{}
+	err := r.GetRequest(context.Background(), &res, ts.URL, "/", nil)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServerError, errors.Kind(err))
+
+	// the deadline of the context takes precedence over the default timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	err = r.GetRequest(ctx, &res, ts.URL, "/", nil)
+	require.NoError(t, err)
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	config, err := NewTLSConfig("", "", "")
+	require.NoError(t, err)
+	assert.Nil(t, config.RootCAs)
+	assert.Empty(t, config.Certificates)
+
+	_, err = NewTLSConfig("/not/exist/ca.crt", "", "")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindIOError, errors.Kind(err))
+}
+
+type testResolver struct {
+	baseUrl atomic.Value
+}
+
+func (r *testResolver) ResolveEndpoint(_ context.Context) (string, error) {
+	return r.baseUrl.Load().(string), nil
+}
+
+func TestRequesterWithEndpointResolver(t *testing.T) {
+	var first, second int32
+	ts1 := newFlakyServer(0, http.StatusOK, &first)
+	defer ts1.Close()
+	ts2 := newFlakyServer(0, http.StatusOK, &second)
+	defer ts2.Close()
+
+	resolver := &testResolver{}
+	resolver.baseUrl.Store(ts1.URL)
+	r := NewRequester(WithEndpointResolver(resolver))
+	res := dtoCommon.BaseResponse{}
+	require.NoError(t, r.GetRequest(context.Background(), &res, "", "/", nil))
+	require.NoError(t, r.PostRequestWithRawData(context.Background(), &res, "http://stale-host:59880/", nil))
+
+	resolver.baseUrl.Store(ts2.URL)
+	require.NoError(t, r.GetRequest(context.Background(), &res, "", "/", nil))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&first))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&second))
+}
@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// newResponseError decodes the body of a failed response, either a BaseResponse or an array of BaseResponse, into an
+// errors.ResponseError. A body that can't be decoded is reported as is in the server message.
+func newResponseError(statusCode int, body []byte) errors.ResponseError {
+	trimmed := strings.TrimSpace(string(body))
+
+	switch {
+	case strings.HasPrefix(trimmed, "{"):
+		var res dtoCommon.BaseResponse
+		if err := json.Unmarshal(body, &res); err == nil && (res.Message != "" || res.RequestId != "") {
+			return errors.NewResponseError(statusCode, res.RequestId, res.Message, failedRequestMessage(statusCode, res.Message), nil)
+		}
+	case strings.HasPrefix(trimmed, "["):
+		var res []dtoCommon.BaseResponse
+		if err := json.Unmarshal(body, &res); err == nil && len(res) > 0 {
+			items := make([]errors.ResponseError, len(res))
+			messages := make([]string, 0, len(res))
+			for i, item := range res {
+				itemStatusCode := item.StatusCode
+				if itemStatusCode == 0 {
+					itemStatusCode = statusCode
+				}
+				items[i] = errors.NewResponseError(itemStatusCode, item.RequestId, item.Message, item.Message, nil)
+				if item.Message != "" {
+					messages = append(messages, item.Message)
+				}
+			}
+			serverMessage := strings.Join(messages, "; ")
+			return errors.NewResponseError(statusCode, "", serverMessage, failedRequestMessage(statusCode, serverMessage), items)
+		}
+	}
+	return errors.NewResponseError(statusCode, "", string(body), failedRequestMessage(statusCode, string(body)), nil)
+}
+
+func failedRequestMessage(statusCode int, serverMessage string) string {
+	return fmt.Sprintf("request failed, status code: %d, err: %s", statusCode, serverMessage)
+}
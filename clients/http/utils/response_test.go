@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResponseError(t *testing.T) {
+	single, _ := json.Marshal(dtoCommon.NewBaseResponse("request-id", "device name exists", http.StatusConflict))
+	multi, _ := json.Marshal([]dtoCommon.BaseResponse{
+		dtoCommon.NewBaseResponse("request-1", "invalid device", http.StatusBadRequest),
+		dtoCommon.NewBaseResponse("request-2", "device name exists", http.StatusConflict),
+	})
+
+	tests := []struct {
+		name                  string
+		statusCode            int
+		body                  []byte
+		expectedKind          errors.ErrKind
+		expectedRequestId     string
+		expectedServerMessage string
+		expectedItemKinds     []errors.ErrKind
+	}{
+		{"base response", http.StatusConflict, single, errors.KindStatusConflict, "request-id", "device name exists", nil},
+		{"array of base responses", http.StatusBadRequest, multi, errors.KindContractInvalid, "", "invalid device; device name exists",
+			[]errors.ErrKind{errors.KindContractInvalid, errors.KindStatusConflict}},
+		{"plain text", http.StatusBadGateway, []byte("bad gateway"), errors.KindCommunicationError, "", "bad gateway", nil},
+		{"empty body", http.StatusNotFound, nil, errors.KindEntityDoesNotExist, "", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newResponseError(tt.statusCode, tt.body)
+			assert.Equal(t, tt.expectedKind, errors.Kind(err))
+			assert.Equal(t, tt.statusCode, err.Code())
+			assert.Equal(t, tt.expectedRequestId, err.RequestId)
+			assert.Equal(t, tt.expectedServerMessage, err.ServerMessage)
+			require.Len(t, err.Items, len(tt.expectedItemKinds))
+			for i, kind := range tt.expectedItemKinds {
+				assert.Equal(t, kind, errors.Kind(err.Items[i]))
+			}
+		})
+	}
+}
+
+func TestRequestReturnsResponseError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		b, _ := json.Marshal(dtoCommon.NewBaseResponse("request-id", "device not found", http.StatusNotFound))
+		_, _ = w.Write(b)
+	}))
+	defer ts.Close()
+
+	res := dtoCommon.BaseResponse{}
+	err := GetRequest(context.Background(), &res, ts.URL, "/", nil)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	responseErr, ok := errors.AsResponseError(err)
+	require.True(t, ok)
+	assert.Equal(t, "request-id", responseErr.RequestId)
+	assert.Equal(t, "device not found", responseErr.ServerMessage)
+}
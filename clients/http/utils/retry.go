@@ -0,0 +1,188 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+const (
+	defaultMaxAttempts    = 3
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 5 * time.Second
+	defaultMultiplier     = 2.0
+	defaultJitter         = 0.2
+
+	retryAfterHeader = "Retry-After"
+)
+
+// RetryPolicy defines how the Requester retries the requests failed with a transport error or a 5xx/429 response.
+// Only the idempotent methods (GET, HEAD, OPTIONS, PUT and DELETE) are retried by default. POST and PATCH requests are
+// retried only if RetryDeduplicatedRequests is enabled and every request DTO in the body carries the requestId of
+// BaseRequest, so that the service is able to deduplicate the repeated requests.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one. Default is 3.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. Default is 100ms.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts, including the Retry-After delay sent by the service. Default is 5s.
+	MaxBackoff time.Duration
+	// Multiplier is the factor applied to the delay after each retry. Default is 2.
+	Multiplier float64
+	// Jitter randomizes the delay within +/- the specified fraction, e.g. 0.2 for +/-20%. Default is 0.2, a negative
+	// value disables the jitter.
+	Jitter float64
+	// RetryDeduplicatedRequests enables the retry of POST and PATCH requests carrying requestIds.
+	RetryDeduplicatedRequests bool
+}
+
+// WithRetryPolicy enables the retry of the failed requests with the specified policy.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(o *clientOptions) {
+		p := policy.withDefaults()
+		o.retryPolicy = &p
+	}
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = defaultMaxAttempts
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = defaultInitialBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = defaultMaxBackoff
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = defaultMultiplier
+	}
+	if p.Jitter == 0 {
+		p.Jitter = defaultJitter
+	} else if p.Jitter < 0 {
+		p.Jitter = 0
+	}
+	return p
+}
+
+// backoff returns the delay before the next attempt. The Retry-After delay sent by the service takes precedence over
+// the computed one, both being capped by MaxBackoff so that a service can't hold the caller for longer.
+func (p RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		if retryAfter > p.MaxBackoff {
+			return p.MaxBackoff
+		}
+		return retryAfter
+	}
+	delay := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+	if p.Jitter > 0 {
+		delay = delay * (1 - p.Jitter + 2*p.Jitter*rand.Float64())
+	}
+	if delay > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	return time.Duration(delay)
+}
+
+// isRetryableRequest checks whether the request can be sent again without side effects
+func (p RetryPolicy) isRetryableRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return req.Body == nil || req.GetBody != nil
+	case http.MethodPost, http.MethodPatch:
+		return p.RetryDeduplicatedRequests && req.GetBody != nil && carriesRequestIds(req)
+	default:
+		return false
+	}
+}
+
+// isRetryableStatus checks whether the status code indicates a transient failure. The status code 0 stands for
+// a transport error.
+func isRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case 0, http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses the Retry-After header, which is either a number of seconds or a HTTP date.
+func parseRetryAfter(header http.Header) time.Duration {
+	value := strings.TrimSpace(header.Get(retryAfterHeader))
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
+
+// carriesRequestIds checks whether the JSON or CBOR body is a request DTO, or an array of request DTOs, with non-empty
+// requestId.
+func carriesRequestIds(req *http.Request) bool {
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	defer body.Close()
+	data, err := ioutil.ReadAll(body)
+	if err != nil || len(data) == 0 {
+		return false
+	}
+
+	var unmarshal func([]byte, interface{}) error
+	switch req.Header.Get(common.ContentType) {
+	case common.ContentTypeCBOR:
+		unmarshal = cbor.Unmarshal
+	case common.ContentTypeJSON, "":
+		unmarshal = json.Unmarshal
+	default:
+		return false
+	}
+
+	type identified struct {
+		RequestId string `json:"requestId"`
+	}
+	var multiple []identified
+	if err := unmarshal(data, &multiple); err == nil {
+		if len(multiple) == 0 {
+			return false
+		}
+		for _, r := range multiple {
+			if r.RequestId == "" {
+				return false
+			}
+		}
+		return true
+	}
+	var single identified
+	if err := unmarshal(data, &single); err == nil {
+		return single.RequestId != ""
+	}
+	return false
+}
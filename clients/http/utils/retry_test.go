@@ -0,0 +1,167 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFlakyServer responds with the failedStatusCode for the first failures requests and 200 afterwards
+func newFlakyServer(failures int32, failedStatusCode int, counter *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(counter, 1) <= failures {
+			w.WriteHeader(failedStatusCode)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("{}"))
+	}))
+}
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond, Jitter: -1}
+}
+
+func TestRetryIdempotentRequests(t *testing.T) {
+	tests := []struct {
+		name             string
+		failures         int32
+		failedStatusCode int
+		expectedErrKind  errors.ErrKind
+		expectedAttempts int32
+	}{
+		{"recover after retry", 2, http.StatusServiceUnavailable, "", 3},
+		{"exceed max attempts", 3, http.StatusBadGateway, errors.KindCommunicationError, 3},
+		{"not retryable status", 1, http.StatusNotFound, errors.KindEntityDoesNotExist, 1},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var counter int32
+			ts := newFlakyServer(testCase.failures, testCase.failedStatusCode, &counter)
+			defer ts.Close()
+
+			r := NewRequester(WithRetryPolicy(testRetryPolicy()))
+			res := dtoCommon.BaseResponse{}
+			err := r.GetRequest(context.Background(), &res, ts.URL, "/", nil)
+			if testCase.expectedErrKind == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedErrKind, errors.Kind(err))
+			}
+			assert.Equal(t, testCase.expectedAttempts, atomic.LoadInt32(&counter))
+		})
+	}
+}
+
+func TestRetryNonIdempotentRequests(t *testing.T) {
+	withRequestId := []dtoCommon.BaseRequest{dtoCommon.NewBaseRequest(), dtoCommon.NewBaseRequest()}
+	withoutRequestId := []dtoCommon.BaseRequest{dtoCommon.NewBaseRequest(), {}}
+
+	tests := []struct {
+		name                      string
+		retryDeduplicatedRequests bool
+		data                      interface{}
+		expectedAttempts          int32
+	}{
+		{"not opted in", false, withRequestId, 1},
+		{"opted in with requestIds", true, withRequestId, 2},
+		{"opted in without requestIds", true, withoutRequestId, 1},
+		{"opted in with single requestId", true, dtoCommon.NewBaseRequest(), 2},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var counter int32
+			ts := newFlakyServer(1, http.StatusServiceUnavailable, &counter)
+			defer ts.Close()
+
+			policy := testRetryPolicy()
+			policy.RetryDeduplicatedRequests = testCase.retryDeduplicatedRequests
+			r := NewRequester(WithRetryPolicy(policy))
+			var res []dtoCommon.BaseWithIdResponse
+			_ = r.PostRequestWithRawData(context.Background(), &res, ts.URL, testCase.data)
+			assert.Equal(t, testCase.expectedAttempts, atomic.LoadInt32(&counter))
+		})
+	}
+}
+
+func TestRetryStopsWhenContextDone(t *testing.T) {
+	var counter int32
+	ts := newFlakyServer(10, http.StatusServiceUnavailable, &counter)
+	defer ts.Close()
+
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 10
+	policy.InitialBackoff = time.Second
+	policy.MaxBackoff = time.Second
+	r := NewRequester(WithRetryPolicy(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	res := dtoCommon.BaseResponse{}
+	err := r.GetRequest(ctx, &res, ts.URL, "/", nil)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(err))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counter))
+}
+
+func TestRetryAfterIsCapped(t *testing.T) {
+	var counter int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&counter, 1) == 1 {
+			w.Header().Set(retryAfterHeader, "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer ts.Close()
+
+	r := NewRequester(WithRetryPolicy(testRetryPolicy()))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	res := dtoCommon.BaseResponse{}
+	require.NoError(t, r.GetRequest(ctx, &res, ts.URL, "/", nil))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&counter))
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Jitter: -1}.withDefaults()
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1, 0))
+	assert.Equal(t, 200*time.Millisecond, policy.backoff(2, 0))
+	assert.Equal(t, 400*time.Millisecond, policy.backoff(3, 0))
+	assert.Equal(t, time.Second, policy.backoff(10, 0))
+	assert.Equal(t, 500*time.Millisecond, policy.backoff(1, 500*time.Millisecond), "Retry-After should take precedence")
+	assert.Equal(t, time.Second, policy.backoff(1, time.Hour), "Retry-After should be capped by MaxBackoff")
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		delay := policy.backoff(1, 0)
+		assert.True(t, delay >= 50*time.Millisecond && delay <= 150*time.Millisecond)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	header := http.Header{}
+	assert.Equal(t, time.Duration(0), parseRetryAfter(header))
+	header.Set(retryAfterHeader, "2")
+	assert.Equal(t, 2*time.Second, parseRetryAfter(header))
+	header.Set(retryAfterHeader, "invalid")
+	assert.Equal(t, time.Duration(0), parseRetryAfter(header))
+	header.Set(retryAfterHeader, time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	assert.True(t, parseRetryAfter(header) > 59*time.Minute)
+}
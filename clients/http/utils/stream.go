@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// decodeArrayField decodes the JSON object from the reader token by token. Each item of the arrayField is decoded by
+// decodeItem as soon as it is read, and the other fields are unmarshalled to the returnValuePointer.
+func decodeArrayField(reader io.Reader, returnValuePointer interface{}, arrayField string, decodeItem func(*json.Decoder) error) errors.EdgeX {
+	decoder := json.NewDecoder(reader)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	others := make(map[string]json.RawMessage)
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+		}
+		key, ok := token.(string)
+		if !ok {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unexpected token %v in the response body", token), nil)
+		}
+
+		if key != arrayField {
+			var raw json.RawMessage
+			if err := decoder.Decode(&raw); err != nil {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+			}
+			others[key] = raw
+			continue
+		}
+
+		token, err = decoder.Token()
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+		}
+		if token == nil {
+			continue
+		}
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the %s field of the response body is not an array", arrayField), nil)
+		}
+		for decoder.More() {
+			if err := decodeItem(decoder); err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
+		}
+		if err := expectDelim(decoder, ']'); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if err := expectDelim(decoder, '}'); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	if returnValuePointer == nil {
+		return nil
+	}
+	remaining, err := json.Marshal(others)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+	}
+	if err := json.Unmarshal(remaining, returnValuePointer); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+	}
+	return nil
+}
+
+func expectDelim(decoder *json.Decoder, expected json.Delim) errors.EdgeX {
+	token, err := decoder.Token()
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != expected {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unexpected token %v in the response body, expected %v", token, expected), nil)
+	}
+	return nil
+}
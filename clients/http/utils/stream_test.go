@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+func TestDecodeArrayField(t *testing.T) {
+	type response struct {
+		ApiVersion string `json:"apiVersion"`
+		TotalCount uint32 `json:"totalCount"`
+	}
+	decodeInt := func(items *[]int) func(*json.Decoder) error {
+		return func(decoder *json.Decoder) error {
+			var item int
+			if err := decoder.Decode(&item); err != nil {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the item", err)
+			}
+			*items = append(*items, item)
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name          string
+		body          string
+		expectedItems []int
+		expectedCount uint32
+		errorExpected bool
+	}{
+		{"array before the other fields", `{"items":[1,2,3],"apiVersion":"v2","totalCount":7}`, []int{1, 2, 3}, 7, false},
+		{"array after the other fields", `{"apiVersion":"v2","totalCount":7,"items":[4]}`, []int{4}, 7, false},
+		{"null array", `{"apiVersion":"v2","items":null,"totalCount":1}`, nil, 1, false},
+		{"no array", `{"apiVersion":"v2","totalCount":0}`, nil, 0, false},
+		{"not an array", `{"items":{"a":1}}`, nil, 0, true},
+		{"not an object", `[1,2]`, nil, 0, true},
+		{"truncated body", `{"items":[1,2`, []int{1, 2}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var items []int
+			res := response{}
+			err := decodeArrayField(strings.NewReader(tt.body), &res, "items", decodeInt(&items))
+			assert.Equal(t, tt.expectedItems, items)
+			if tt.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "v2", res.ApiVersion)
+			assert.Equal(t, tt.expectedCount, res.TotalCount)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// UnixSocketScheme is the scheme of the base URLs of the services listening on a Unix domain socket, e.g.
+// unix:///run/edgex/core-metadata.sock. The path of the socket is the path of the URL up to the API base path, so that
+// both unix:///run/edgex/core-metadata.sock and unix:///run/edgex/core-metadata.sock/api/v2/ping can be used.
+//
+// The requests to such a base URL are sent over HTTP through the socket. They are dialed by the transport of the
+// Requester, a http.Client or a http.RoundTripper injected with WithHTTPClient or WithTransport must dial the sockets
+// with NewUnixSocketDialContext.
+const UnixSocketScheme = "unix"
+
+// unixSocketHostSuffix ends the host standing for a socket in the URLs actually sent, the socket path being hex encoded
+// in the host so that each socket has its own connection pool. The hosts under the .invalid top-level domain, reserved
+// by RFC 6761, never resolve, so that the host of a real service can't be mistaken for a socket.
+const unixSocketHostSuffix = ".unix.invalid"
+
+// NewUnixSocketDialContext wraps the DialContext of a http.Transport, so that the requests to a base URL of the unix
+// scheme are dialed through the socket, see UnixSocketScheme.
+func NewUnixSocketDialContext(dial func(ctx context.Context, network string, address string) (net.Conn, error)) func(ctx context.Context, network string, address string) (net.Conn, error) {
+	return func(ctx context.Context, network string, address string) (net.Conn, error) {
+		if host, _, err := net.SplitHostPort(address); err == nil {
+			if socketPath, ok := unixSocketPath(host); ok {
+				return dial(ctx, "unix", socketPath)
+			}
+		}
+		return dial(ctx, network, address)
+	}
+}
+
+// skipProxyForUnixSocket wraps the Proxy of a http.Transport, so that the requests sent through a socket never go
+// through a proxy
+func skipProxyForUnixSocket(proxy func(*http.Request) (*url.URL, error)) func(*http.Request) (*url.URL, error) {
+	return func(req *http.Request) (*url.URL, error) {
+		if _, ok := unixSocketPath(req.URL.Hostname()); ok {
+			return nil, nil
+		}
+		return proxy(req)
+	}
+}
+
+// parseBaseUrl parses the base URL of a request, translating a base URL of the unix scheme into the one sent through
+// the socket
+func parseBaseUrl(baseUrl string) (*url.URL, errors.EdgeX) {
+	u, err := url.Parse(baseUrl)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "fail to parse baseUrl", err)
+	}
+	if u.Scheme == UnixSocketScheme {
+		if edgexErr := translateUnixSocketUrl(u); edgexErr != nil {
+			return nil, edgexErr
+		}
+	}
+	return u, nil
+}
+
+// requestUrl translates a request URL of the unix scheme into the one sent through the socket, the other URLs are
+// returned as is
+func requestUrl(rawUrl string) (string, errors.EdgeX) {
+	if !strings.HasPrefix(rawUrl, UnixSocketScheme+":") {
+		return rawUrl, nil
+	}
+	u, err := parseBaseUrl(rawUrl)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// translateUnixSocketUrl replaces the scheme and the host of a URL of the unix scheme with the ones standing for the
+// socket, and strips the socket path from the path of the URL
+func translateUnixSocketUrl(u *url.URL) errors.EdgeX {
+	escapedPath := u.EscapedPath()
+	socketPath, requestPath := escapedPath, ""
+	if i := apiBaseIndex(escapedPath); i >= 0 {
+		socketPath, requestPath = escapedPath[:i], escapedPath[i:]
+	}
+	socketPath, err := url.PathUnescape(socketPath)
+	if err != nil || socketPath == "" {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("no socket path in the URL %s", u.Redacted()), err)
+	}
+	path, err := url.PathUnescape(requestPath)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("fail to parse the URL %s", u.Redacted()), err)
+	}
+
+	u.Scheme = "http"
+	u.Host = hex.EncodeToString([]byte(socketPath)) + unixSocketHostSuffix
+	u.Path = path
+	u.RawPath = requestPath
+	return nil
+}
+
+// apiBaseIndex returns the position of the API base path in the escaped path, or -1 if the path doesn't contain it
+func apiBaseIndex(escapedPath string) int {
+	offset := 0
+	for {
+		i := strings.Index(escapedPath[offset:], common.ApiBase)
+		if i < 0 {
+			return -1
+		}
+		end := offset + i + len(common.ApiBase)
+		if end == len(escapedPath) || escapedPath[end] == '/' {
+			return offset + i
+		}
+		offset = end
+	}
+}
+
+// unixSocketPath returns the socket path of a host standing for a socket, false if the host doesn't stand for one
+func unixSocketPath(host string) (string, bool) {
+	if !strings.HasSuffix(host, unixSocketHostSuffix) {
+		return "", false
+	}
+	socketPath, err := hex.DecodeString(strings.TrimSuffix(host, unixSocketHostSuffix))
+	if err != nil || len(socketPath) == 0 {
+		return "", false
+	}
+	return string(socketPath), true
+}
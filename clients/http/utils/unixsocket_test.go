@@ -0,0 +1,121 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newUnixSocketServer starts a test server listening on a socket of a temporary directory and returns its base URL
+func newUnixSocketServer(t *testing.T, handler http.Handler) (*httptest.Server, string) {
+	socketPath := filepath.Join(t.TempDir(), "test.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	ts := httptest.NewUnstartedServer(handler)
+	ts.Listener = listener
+	ts.Start()
+	return ts, UnixSocketScheme + "://" + socketPath
+}
+
+func TestRequestUrl(t *testing.T) {
+	tests := []struct {
+		name        string
+		rawUrl      string
+		expectedUrl string
+		socketPath  string
+		errExpected bool
+	}{
+		{"http url", "http://localhost:59881/api/v2/ping", "http://localhost:59881/api/v2/ping", "", false},
+		{"unix socket", "unix:///run/edgex/core-metadata.sock", "http://" + unixSocketHost("/run/edgex/core-metadata.sock"), "/run/edgex/core-metadata.sock", false},
+		{"unix socket with request path", "unix:///run/edgex/core-metadata.sock/api/v2/device/name/my%2Fdevice?offset=0",
+			"http://" + unixSocketHost("/run/edgex/core-metadata.sock") + "/api/v2/device/name/my%2Fdevice?offset=0", "/run/edgex/core-metadata.sock", false},
+		{"unix socket path containing the API base", "unix:///run/api/v2x/metadata/api/v2/ping",
+			"http://" + unixSocketHost("/run/api/v2x/metadata") + "/api/v2/ping", "/run/api/v2x/metadata", false},
+		{"no socket path", "unix:///api/v2/ping", "", "", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			u, err := requestUrl(testCase.rawUrl)
+			if testCase.errExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedUrl, u)
+			if testCase.socketPath != "" {
+				assert.Equal(t, UnixSocketScheme+"://"+testCase.socketPath, baseUrlKey(u))
+			}
+		})
+	}
+}
+
+func TestUnixSocketDialContextIgnoresRealHosts(t *testing.T) {
+	var dialed []string
+	dial := NewUnixSocketDialContext(func(_ context.Context, network string, address string) (net.Conn, error) {
+		dialed = append(dialed, network+" "+address)
+		return nil, nil
+	})
+	for _, address := range []string{"cafe.sock:80", "deadbeef.sock:443", "localhost:59881"} {
+		_, _ = dial(context.Background(), "tcp", address)
+	}
+	_, _ = dial(context.Background(), "tcp", unixSocketHost("/run/edgex/core-metadata.sock")+":80")
+	assert.Equal(t, []string{"tcp cafe.sock:80", "tcp deadbeef.sock:443", "tcp localhost:59881", "unix /run/edgex/core-metadata.sock"}, dialed,
+		"only the hosts under the reserved domain are dialed as sockets")
+}
+
+func unixSocketHost(socketPath string) string {
+	u, err := parseBaseUrl(UnixSocketScheme + "://" + socketPath)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+func TestRequesterOverUnixSocket(t *testing.T) {
+	var fileContent string
+	ts, baseUrl := newUnixSocketServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != common.ApiPingRoute {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.Method == http.MethodPost && r.Header.Get(common.ContentType) != common.ContentTypeJSON {
+			file, _, err := r.FormFile("file")
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			content, _ := ioutil.ReadAll(file)
+			fileContent = string(content)
+		}
+		b, _ := json.Marshal(dtoCommon.NewBaseResponse("", "", http.StatusOK))
+		_, _ = w.Write(b)
+	}))
+	defer ts.Close()
+
+	r := NewRequester()
+	res := dtoCommon.BaseResponse{}
+	require.NoError(t, r.GetRequest(context.Background(), &res, baseUrl, common.ApiPingRoute, nil))
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	require.NoError(t, r.PostRequestWithRawData(context.Background(), &res, baseUrl+common.ApiPingRoute, map[string]string{}))
+
+	filePath := filepath.Join(t.TempDir(), "profile.yaml")
+	require.NoError(t, ioutil.WriteFile(filePath, []byte("name: test"), 0600))
+	require.NoError(t, r.PostByFileRequest(context.Background(), &res, baseUrl+common.ApiPingRoute, filePath))
+	assert.Equal(t, "name: test", fileContent)
+}
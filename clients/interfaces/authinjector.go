@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+	"net/http"
+)
+
+// AuthenticationInjector defines the interface for adding authentication data to the outbound requests of the clients.
+type AuthenticationInjector interface {
+	// AddAuthenticationData mutates the HTTP request to add authentication data, such as the Authorization header.
+	AddAuthenticationData(req *http.Request) error
+}
+
+// AuthenticationRefresher defines the interface for the AuthenticationInjector whose credentials can be renewed.
+// When a request is rejected with 401 Unauthorized, the clients invoke RefreshAuthenticationData and retry the
+// request once.
+type AuthenticationRefresher interface {
+	// RefreshAuthenticationData discards the cached credentials and obtains new ones.
+	RefreshAuthenticationData(ctx context.Context) error
+}
@@ -0,0 +1,30 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+)
+
+// EndpointResolver defines the interface for resolving the base URL at which a service is currently reachable, so that
+// the clients follow a service moving to another host without being recreated.
+type EndpointResolver interface {
+	// ResolveEndpoint returns the base URL of the service, e.g. http://localhost:59880. Only the scheme and the host
+	// of the base URL are used by the clients.
+	ResolveEndpoint(ctx context.Context) (string, error)
+}
+
+// FailoverEndpointResolver defines the interface for the EndpointResolver choosing among several endpoints of a
+// service. The clients report the health of the endpoints observed by each request, and send the request again to
+// another endpoint when the service can't be reached at the resolved one.
+type FailoverEndpointResolver interface {
+	EndpointResolver
+	// ReportEndpointHealth reports whether the service was reachable at the base URL, the scheme and the host of the
+	// request URL
+	ReportEndpointHealth(baseUrl string, healthy bool)
+	// EndpointCount returns the number of endpoints, which bounds the number of failovers of a request
+	EndpointCount() int
+}
@@ -0,0 +1,34 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// EventHandler handles an event as soon as it is decoded. Returning an error stops the streaming and the error is
+// returned to the caller of the streaming method.
+type EventHandler func(event dtos.Event) error
+
+// EventStreamClient defines the interface for streaming the events queried from the Event endpoint on the EdgeX
+// Foundry core-data service. Instead of unmarshalling the whole response, the events are decoded one at a time and
+// handed over to the handler, so the memory usage doesn't grow with the limit. Each method returns the base fields of
+// the response, e.g. the totalCount, once all the events have been handled.
+type EventStreamClient interface {
+	// StreamAllEvents streams all events sorted in descending order of created time.
+	// offset: The number of items to skip before starting to collect the result set. Default is 0.
+	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
+	StreamAllEvents(ctx context.Context, offset, limit int, handler EventHandler) (common.BaseWithTotalCountResponse, errors.EdgeX)
+	// StreamEventsByDeviceName streams the events according to the device name, offset and limit parameters. Events are sorted in descending order of created time.
+	StreamEventsByDeviceName(ctx context.Context, name string, offset, limit int, handler EventHandler) (common.BaseWithTotalCountResponse, errors.EdgeX)
+	// StreamEventsByTimeRange streams the events between a given start and end date/time. Events are sorted in descending order of created time.
+	// start, end: Unix timestamp, indicating the date/time range.
+	StreamEventsByTimeRange(ctx context.Context, start, end, offset, limit int, handler EventHandler) (common.BaseWithTotalCountResponse, errors.EdgeX)
+}
@@ -0,0 +1,45 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// ReadingHandler handles a reading as soon as it is decoded. Returning an error stops the streaming and the error is
+// returned to the caller of the streaming method.
+type ReadingHandler func(reading dtos.BaseReading) error
+
+// ReadingStreamClient defines the interface for streaming the readings queried from the Reading endpoint on the EdgeX
+// Foundry core-data service. Instead of unmarshalling the whole response, the readings are decoded one at a time and
+// handed over to the handler, so the memory usage doesn't grow with the limit. Each method returns the base fields of
+// the response, e.g. the totalCount, once all the readings have been handled.
+type ReadingStreamClient interface {
+	// StreamAllReadings streams all readings sorted in descending order of created time.
+	// offset: The number of items to skip before starting to collect the result set. Default is 0.
+	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
+	StreamAllReadings(ctx context.Context, offset, limit int, handler ReadingHandler) (common.BaseWithTotalCountResponse, errors.EdgeX)
+	// StreamReadingsByDeviceName streams the readings according to the device name, offset and limit parameters. Readings are sorted in descending order of created time.
+	StreamReadingsByDeviceName(ctx context.Context, name string, offset, limit int, handler ReadingHandler) (common.BaseWithTotalCountResponse, errors.EdgeX)
+	// StreamReadingsByResourceName streams the readings according to the device resource name, offset and limit parameters. Readings are sorted in descending order of created time.
+	StreamReadingsByResourceName(ctx context.Context, name string, offset, limit int, handler ReadingHandler) (common.BaseWithTotalCountResponse, errors.EdgeX)
+	// StreamReadingsByTimeRange streams the readings between a given start and end date/time. Readings are sorted in descending order of created time.
+	// start, end: Unix timestamp, indicating the date/time range.
+	StreamReadingsByTimeRange(ctx context.Context, start, end, offset, limit int, handler ReadingHandler) (common.BaseWithTotalCountResponse, errors.EdgeX)
+	// StreamReadingsByResourceNameAndTimeRange streams the readings by resource name and specified time range. Readings are sorted in descending order of origin time.
+	StreamReadingsByResourceNameAndTimeRange(ctx context.Context, name string, start, end, offset, limit int, handler ReadingHandler) (common.BaseWithTotalCountResponse, errors.EdgeX)
+	// StreamReadingsByDeviceNameAndResourceName streams the readings by device name and resource name. Readings are sorted in descending order of origin time.
+	StreamReadingsByDeviceNameAndResourceName(ctx context.Context, deviceName, resourceName string, offset, limit int, handler ReadingHandler) (common.BaseWithTotalCountResponse, errors.EdgeX)
+	// StreamReadingsByDeviceNameAndResourceNameAndTimeRange streams the readings by device name, resource name and specified time range. Readings are sorted in descending order of origin time.
+	StreamReadingsByDeviceNameAndResourceNameAndTimeRange(ctx context.Context, deviceName, resourceName string, start, end, offset, limit int, handler ReadingHandler) (common.BaseWithTotalCountResponse, errors.EdgeX)
+	// StreamReadingsByDeviceNameAndResourceNamesAndTimeRange streams the readings by device name, multiple resource names and specified time range. Readings are sorted in descending order of origin time.
+	// If none of resourceNames is specified, stream all Readings under specified deviceName and within specified time range
+	StreamReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx context.Context, deviceName string, resourceNames []string, start, end, offset, limit int, handler ReadingHandler) (common.BaseWithTotalCountResponse, errors.EdgeX)
+}
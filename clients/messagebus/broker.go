@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messagebus
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/messagebus/topic"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// Handler handles the messages published on a topic. The message is shared by all the handlers of the topic and must
+// not be modified.
+type Handler func(topic string, msg Message)
+
+type subscription struct {
+	sequence int
+	handler  Handler
+}
+
+// Broker is an in-process Publisher delivering each message to the handlers subscribed to a pattern matching its
+// topic, the patterns being MQTT-style wildcards as defined by the topic package. The handlers are called synchronously
+// by Publish, in the order of their subscription, and the messages published on a topic without subscribers are
+// dropped, as on a real message bus.
+type Broker struct {
+	subscriptions *topic.Matcher
+	sequence      int
+	closed        bool
+	mutex         sync.RWMutex
+}
+
+// NewBroker creates a Broker without subscriptions
+func NewBroker() *Broker {
+	return &Broker{subscriptions: topic.NewMatcher()}
+}
+
+// Publish delivers the message to the handlers subscribed to a pattern matching the topic, which must not contain
+// wildcards. An error of kind errors.KindCommunicationError is returned once the Broker is closed.
+func (b *Broker) Publish(ctx context.Context, t string, msg Message) errors.EdgeX {
+	if err := topic.ValidateTopic(t); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := ctx.Err(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindCommunicationError, "failed to publish the message", err)
+	}
+	b.mutex.RLock()
+	if b.closed {
+		b.mutex.RUnlock()
+		return errors.NewCommonEdgeX(errors.KindCommunicationError, "failed to publish the message, the broker is closed", nil)
+	}
+	matched := b.subscriptions.Match(t)
+	b.mutex.RUnlock()
+
+	subscriptions := make([]*subscription, len(matched))
+	for i, value := range matched {
+		subscriptions[i] = value.(*subscription)
+	}
+	sort.Slice(subscriptions, func(i, j int) bool { return subscriptions[i].sequence < subscriptions[j].sequence })
+	// the handlers are called without holding the lock, so that they can publish or subscribe in turn
+	for _, s := range subscriptions {
+		s.handler(t, msg)
+	}
+	return nil
+}
+
+// Subscribe subscribes the handler to the messages published on the topics matching the pattern, until the returned
+// function is called. An error of kind errors.KindContractInvalid is returned if the pattern is invalid.
+func (b *Broker) Subscribe(pattern string, handler Handler) (unsubscribe func(), err errors.EdgeX) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.sequence++
+	s := &subscription{sequence: b.sequence, handler: handler}
+	if err = b.subscriptions.Add(pattern, s); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return func() {
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+		b.subscriptions.Remove(pattern, s)
+	}, nil
+}
+
+// Close drops the subscriptions, the messages published afterwards failing with a communication error
+func (b *Broker) Close() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.closed = true
+	b.subscriptions = topic.NewMatcher()
+}
@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messagebus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustSubscribe(t *testing.T, broker *Broker, pattern string, handler Handler) func() {
+	t.Helper()
+	unsubscribe, err := broker.Subscribe(pattern, handler)
+	require.NoError(t, err)
+	return unsubscribe
+}
+
+func TestBrokerPublish(t *testing.T) {
+	broker := NewBroker()
+	var received []string
+	mustSubscribe(t, broker, "topic1", func(topic string, msg Message) { received = append(received, "first:"+string(msg.Payload)) })
+	mustSubscribe(t, broker, "topic1", func(topic string, msg Message) { received = append(received, "second:"+string(msg.Payload)) })
+	mustSubscribe(t, broker, "topic2", func(topic string, msg Message) { received = append(received, topic+":"+string(msg.Payload)) })
+
+	require.NoError(t, broker.Publish(context.Background(), "topic1", Message{Payload: []byte("a")}))
+	require.NoError(t, broker.Publish(context.Background(), "topic2", Message{Payload: []byte("b")}))
+	require.NoError(t, broker.Publish(context.Background(), "unsubscribed", Message{Payload: []byte("c")}))
+	assert.Equal(t, []string{"first:a", "second:a", "topic2:b"}, received)
+}
+
+func TestBrokerUnsubscribe(t *testing.T) {
+	broker := NewBroker()
+	count := 0
+	unsubscribe := mustSubscribe(t, broker, "topic", func(string, Message) { count++ })
+	other := 0
+	mustSubscribe(t, broker, "topic", func(string, Message) { other++ })
+
+	require.NoError(t, broker.Publish(context.Background(), "topic", Message{}))
+	unsubscribe()
+	unsubscribe()
+	require.NoError(t, broker.Publish(context.Background(), "topic", Message{}))
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 2, other, "the other subscriptions are kept")
+}
+
+func TestBrokerHandlerPublishes(t *testing.T) {
+	broker := NewBroker()
+	var forwarded []byte
+	mustSubscribe(t, broker, "in", func(_ string, msg Message) {
+		assert.NoError(t, broker.Publish(context.Background(), "out", msg))
+	})
+	mustSubscribe(t, broker, "out", func(_ string, msg Message) { forwarded = msg.Payload })
+
+	require.NoError(t, broker.Publish(context.Background(), "in", Message{Payload: []byte("payload")}))
+	assert.Equal(t, []byte("payload"), forwarded)
+}
+
+func TestBrokerErrors(t *testing.T) {
+	broker := NewBroker()
+	called := false
+	mustSubscribe(t, broker, "topic", func(string, Message) { called = true })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := broker.Publish(ctx, "topic", Message{})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindCommunicationError, errors.Kind(err))
+
+	broker.Close()
+	err = broker.Publish(context.Background(), "topic", Message{})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindCommunicationError, errors.Kind(err))
+	assert.False(t, called)
+}
+
+func TestBrokerWildcards(t *testing.T) {
+	broker := NewBroker()
+	var received []string
+	mustSubscribe(t, broker, "edgex/events/device/#", func(topic string, _ Message) { received = append(received, "all:"+topic) })
+	mustSubscribe(t, broker, "edgex/events/device/+/device1/+", func(topic string, _ Message) { received = append(received, "device1:"+topic) })
+
+	require.NoError(t, broker.Publish(context.Background(), "edgex/events/device/profile1/device1/source1", Message{}))
+	require.NoError(t, broker.Publish(context.Background(), "edgex/events/device/profile1/device2/source1", Message{}))
+	assert.Equal(t, []string{
+		"all:edgex/events/device/profile1/device1/source1",
+		"device1:edgex/events/device/profile1/device1/source1",
+		"all:edgex/events/device/profile1/device2/source1",
+	}, received, "the handlers are called in the order of their subscription")
+}
+
+func TestBrokerInvalidTopics(t *testing.T) {
+	broker := NewBroker()
+	_, err := broker.Subscribe("a/#/c", func(string, Message) {})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	err = broker.Publish(context.Background(), "a/+/c", Message{})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err), "a message can't be published on a pattern")
+}
@@ -0,0 +1,113 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messagebus
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/messagebus/topic"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/requestcontext"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// DefaultEventsBaseTopic is the base topic the device services publish the events on
+const DefaultEventsBaseTopic = "edgex/events/device"
+
+type eventClient struct {
+	bus       Publisher
+	baseTopic string
+	queries   interfaces.EventClient
+}
+
+// NewEventClient creates an EventClient publishing the added events on the bus, on the topic
+// <baseTopic>/<profileName>/<deviceName>/<sourceName> built by the topic package. The queries and deletions are made by
+// the queries EventClient, e.g. the HTTP client of core-data, and fail with an error of kind errors.KindNotImplemented
+// if it is nil.
+func NewEventClient(bus Publisher, baseTopic string, queries interfaces.EventClient) interfaces.EventClient {
+	return &eventClient{
+		bus:       bus,
+		baseTopic: baseTopic,
+		queries:   queries,
+	}
+}
+
+// Add publishes the encoded AddEventRequest on the topic of the event, and answers with the status code and id core-data
+// would answer with. The event is stored asynchronously by the subscribers of the topic, so that it may not be
+// returned by the queries right away.
+func (ec *eventClient) Add(ctx context.Context, req requests.AddEventRequest) (dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	var res dtoCommon.BaseWithIdResponse
+	if err := req.Validate(); err != nil {
+		return res, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid AddEventRequest", err)
+	}
+	payload, encoding, err := req.Encode()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	msg := Message{ContentType: encoding, Headers: requestcontext.Headers(ctx), Payload: payload}
+	if edgexErr := ec.bus.Publish(ctx, topic.FromAddEventRequest(ec.baseTopic, req), msg); edgexErr != nil {
+		return res, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return dtoCommon.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, req.Event.Id), nil
+}
+
+func (ec *eventClient) AllEvents(ctx context.Context, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX) {
+	if ec.queries == nil {
+		return responses.MultiEventsResponse{}, notImplemented()
+	}
+	return ec.queries.AllEvents(ctx, offset, limit)
+}
+
+func (ec *eventClient) EventCount(ctx context.Context) (dtoCommon.CountResponse, errors.EdgeX) {
+	if ec.queries == nil {
+		return dtoCommon.CountResponse{}, notImplemented()
+	}
+	return ec.queries.EventCount(ctx)
+}
+
+func (ec *eventClient) EventCountByDeviceName(ctx context.Context, name string) (dtoCommon.CountResponse, errors.EdgeX) {
+	if ec.queries == nil {
+		return dtoCommon.CountResponse{}, notImplemented()
+	}
+	return ec.queries.EventCountByDeviceName(ctx, name)
+}
+
+func (ec *eventClient) EventsByDeviceName(ctx context.Context, name string, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX) {
+	if ec.queries == nil {
+		return responses.MultiEventsResponse{}, notImplemented()
+	}
+	return ec.queries.EventsByDeviceName(ctx, name, offset, limit)
+}
+
+func (ec *eventClient) DeleteByDeviceName(ctx context.Context, name string) (dtoCommon.BaseResponse, errors.EdgeX) {
+	if ec.queries == nil {
+		return dtoCommon.BaseResponse{}, notImplemented()
+	}
+	return ec.queries.DeleteByDeviceName(ctx, name)
+}
+
+func (ec *eventClient) EventsByTimeRange(ctx context.Context, start, end, offset, limit int) (responses.MultiEventsResponse, errors.EdgeX) {
+	if ec.queries == nil {
+		return responses.MultiEventsResponse{}, notImplemented()
+	}
+	return ec.queries.EventsByTimeRange(ctx, start, end, offset, limit)
+}
+
+func (ec *eventClient) DeleteByAge(ctx context.Context, age int) (dtoCommon.BaseResponse, errors.EdgeX) {
+	if ec.queries == nil {
+		return dtoCommon.BaseResponse{}, notImplemented()
+	}
+	return ec.queries.DeleteByAge(ctx, age)
+}
+
+func notImplemented() errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindNotImplemented, "the message bus EventClient has no EventClient to make the queries", nil)
+}
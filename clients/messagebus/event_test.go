@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messagebus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/conformance"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/fakes"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/requestcontext"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// subscribeStore subscribes the store to the events published under the base topic, the way core-data does
+func subscribeStore(t *testing.T, broker *Broker, store interfaces.EventClient, baseTopic string) {
+	mustSubscribe(t, broker, baseTopic+"/#", func(_ string, msg Message) {
+		req, err := DecodeAddEventRequest(msg)
+		require.NoError(t, err)
+		_, err = store.Add(context.Background(), req)
+		require.NoError(t, err)
+	})
+}
+
+func TestEventClientConformance(t *testing.T) {
+	conformance.RunEventClientSuite(t, func(t *testing.T) interfaces.EventClient {
+		broker := NewBroker()
+		store := fakes.NewEventClient()
+		subscribeStore(t, broker, store, DefaultEventsBaseTopic)
+		return NewEventClient(broker, DefaultEventsBaseTopic, store)
+	})
+}
+
+func TestEventClientAdd(t *testing.T) {
+	broker := NewBroker()
+	var topics []string
+	var messages []Message
+	mustSubscribe(t, broker, "base/profile1/device1/source1", func(topic string, msg Message) {
+		topics = append(topics, topic)
+		messages = append(messages, msg)
+	})
+	client := NewEventClient(broker, "base", nil)
+
+	event := dtos.NewEvent("profile1", "device1", "source1")
+	event.AddBinaryReading("image", []byte{1, 2, 3}, "image/png")
+	req := requests.NewAddEventRequest(event)
+	ctx := requestcontext.WithCorrelationId(context.Background(), "correlation-id")
+	res, err := client.Add(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+	assert.Equal(t, req.RequestId, res.RequestId)
+	assert.Equal(t, event.Id, res.Id)
+
+	require.Len(t, messages, 1)
+	assert.Equal(t, common.ContentTypeCBOR, messages[0].ContentType, "the binary readings are encoded to CBOR")
+	assert.Equal(t, "correlation-id", messages[0].CorrelationId())
+	decoded, err := DecodeAddEventRequest(messages[0])
+	require.NoError(t, err)
+	assert.Equal(t, req, decoded)
+}
+
+func TestEventClientGeneratesCorrelationId(t *testing.T) {
+	broker := NewBroker()
+	var correlationId string
+	mustSubscribe(t, broker, "base/profile1/device1/source1", func(_ string, msg Message) { correlationId = msg.CorrelationId() })
+	client := NewEventClient(broker, "base", nil)
+
+	event := dtos.NewEvent("profile1", "device1", "source1")
+	require.NoError(t, event.AddSimpleReading("temperature", common.ValueTypeInt32, int32(20)))
+	_, err := client.Add(context.Background(), requests.NewAddEventRequest(event))
+	require.NoError(t, err)
+	assert.NotEmpty(t, correlationId)
+}
+
+func TestEventClientAddErrors(t *testing.T) {
+	broker := NewBroker()
+	client := NewEventClient(broker, DefaultEventsBaseTopic, nil)
+
+	_, err := client.Add(context.Background(), requests.NewAddEventRequest(dtos.NewEvent("profile1", "device1", "source1")))
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err), "an event without readings isn't published")
+
+	event := dtos.NewEvent("profile1", "device1", "source1")
+	require.NoError(t, event.AddSimpleReading("temperature", common.ValueTypeInt32, int32(20)))
+	broker.Close()
+	_, err = client.Add(context.Background(), requests.NewAddEventRequest(event))
+	require.Error(t, err)
+	assert.Equal(t, errors.KindCommunicationError, errors.Kind(err), "the error of the bus is returned")
+}
+
+func TestEventClientWithoutQueries(t *testing.T) {
+	client := NewEventClient(NewBroker(), DefaultEventsBaseTopic, nil)
+	_, err := client.AllEvents(context.Background(), 0, -1)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindNotImplemented, errors.Kind(err))
+	_, err = client.EventCount(context.Background())
+	assert.Equal(t, errors.KindNotImplemented, errors.Kind(err))
+	_, err = client.DeleteByAge(context.Background(), 0)
+	assert.Equal(t, errors.KindNotImplemented, errors.Kind(err))
+}
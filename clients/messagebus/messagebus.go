@@ -0,0 +1,63 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package messagebus provides the message bus implementation of the EventClient interface, publishing the events the
+// way the device services do, i.e. the encoded AddEventRequest on a topic built from the profile, device and source
+// names of the event:
+//
+//	client := messagebus.NewEventClient(publisher, messagebus.DefaultEventsBaseTopic, httpEventClient)
+//	res, err := client.Add(ctx, requests.NewAddEventRequest(event))
+//
+// The bus is reached through the small Publisher interface, so that any message bus client, e.g. MQTT, Redis Pub/Sub
+// or ZeroMQ, can be plugged in with a thin adapter. The Broker is an in-process Publisher delivering the messages to
+// the handlers subscribed to their topic, mostly intended for tests.
+package messagebus
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// Message is a message published on the bus. The Headers carry the request context the HTTP clients would send as
+// headers: the request metadata, the Correlation ID and the W3C Trace Context.
+type Message struct {
+	ContentType string
+	Headers     http.Header
+	Payload     []byte
+}
+
+// CorrelationId returns the Correlation ID of the message, or an empty string if there is none
+func (m Message) CorrelationId() string {
+	return m.Headers.Get(common.CorrelationHeader)
+}
+
+// Publisher publishes the messages on a message bus. Publish returns an error of kind errors.KindCommunicationError
+// when the bus can't be reached, so that the callers can retry or buffer the message.
+type Publisher interface {
+	Publish(ctx context.Context, topic string, msg Message) errors.EdgeX
+}
+
+// DecodeAddEventRequest decodes the AddEventRequest published by the EventClient, as JSON or CBOR according to the
+// content type of the message, and validates it
+func DecodeAddEventRequest(msg Message) (requests.AddEventRequest, errors.EdgeX) {
+	var req requests.AddEventRequest
+	var err error
+	switch msg.ContentType {
+	case common.ContentTypeCBOR:
+		err = req.UnmarshalCBOR(msg.Payload)
+	case common.ContentTypeJSON, "":
+		err = req.UnmarshalJSON(msg.Payload)
+	default:
+		return req, errors.NewCommonEdgeX(errors.KindContractInvalid, "unsupported content type "+msg.ContentType, nil)
+	}
+	if err != nil {
+		return req, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the AddEventRequest", err)
+	}
+	return req, nil
+}
@@ -0,0 +1,73 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messagebus
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeAddEventRequest(t *testing.T) {
+	simple := dtos.NewEvent("profile1", "device1", "source1")
+	require.NoError(t, simple.AddSimpleReading("temperature", common.ValueTypeInt32, int32(20)))
+	binary := dtos.NewEvent("profile1", "device1", "source1")
+	binary.AddBinaryReading("image", []byte{1, 2, 3}, "image/png")
+
+	tests := []struct {
+		name                string
+		event               dtos.Event
+		expectedContentType string
+	}{
+		{"JSON", simple, common.ContentTypeJSON},
+		{"CBOR", binary, common.ContentTypeCBOR},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := requests.NewAddEventRequest(testCase.event)
+			payload, contentType, err := req.Encode()
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedContentType, contentType)
+
+			decoded, edgexErr := DecodeAddEventRequest(Message{ContentType: contentType, Payload: payload})
+			require.NoError(t, edgexErr)
+			assert.Equal(t, req, decoded)
+		})
+	}
+}
+
+func TestDecodeAddEventRequestErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  Message
+	}{
+		{"unsupported content type", Message{ContentType: "text/plain", Payload: []byte("event")}},
+		{"invalid JSON", Message{ContentType: common.ContentTypeJSON, Payload: []byte("{")}},
+		{"invalid request", Message{ContentType: common.ContentTypeJSON, Payload: []byte(`{"event":{}}`)}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := DecodeAddEventRequest(testCase.msg)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
+
+func TestMessageCorrelationId(t *testing.T) {
+	msg := Message{Headers: http.Header{}}
+	assert.Empty(t, msg.CorrelationId())
+	msg.Headers.Set(common.CorrelationHeader, "id")
+	assert.Equal(t, "id", msg.CorrelationId())
+	assert.Empty(t, Message{}.CorrelationId())
+}
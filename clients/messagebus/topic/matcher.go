@@ -0,0 +1,113 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package topic
+
+import (
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// node is a level of the patterns of a Matcher, holding the values of the patterns ending with this level
+type node struct {
+	children map[string]*node
+	values   []interface{}
+}
+
+// Matcher matches the topics against a set of patterns, each pattern being associated with values, e.g. the
+// subscriptions of a broker. The patterns are stored in a trie of their levels, so that matching a topic only walks
+// the levels of the topic, whatever the number of patterns. A Matcher isn't safe for concurrent use.
+type Matcher struct {
+	root  node
+	count int
+}
+
+// NewMatcher creates a Matcher without patterns
+func NewMatcher() *Matcher {
+	return &Matcher{}
+}
+
+// Add associates the value with the pattern. The value must be comparable, e.g. a pointer, so that it can be removed.
+func (m *Matcher) Add(pattern string, value interface{}) errors.EdgeX {
+	if err := ValidatePattern(pattern); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	n := &m.root
+	for _, level := range strings.Split(pattern, Separator) {
+		child, ok := n.children[level]
+		if !ok {
+			if n.children == nil {
+				n.children = make(map[string]*node)
+			}
+			child = &node{}
+			n.children[level] = child
+		}
+		n = child
+	}
+	n.values = append(n.values, value)
+	m.count++
+	return nil
+}
+
+// Remove removes the value associated with the pattern, and reports whether it was found
+func (m *Matcher) Remove(pattern string, value interface{}) bool {
+	removed := remove(&m.root, strings.Split(pattern, Separator), value)
+	if removed {
+		m.count--
+	}
+	return removed
+}
+
+// remove removes the value from the descendant of n at the levels, pruning the nodes left empty
+func remove(n *node, levels []string, value interface{}) bool {
+	if len(levels) == 0 {
+		for i, v := range n.values {
+			if v == value {
+				n.values = append(n.values[:i:i], n.values[i+1:]...)
+				return true
+			}
+		}
+		return false
+	}
+	child, ok := n.children[levels[0]]
+	if !ok || !remove(child, levels[1:], value) {
+		return false
+	}
+	if len(child.values) == 0 && len(child.children) == 0 {
+		delete(n.children, levels[0])
+	}
+	return true
+}
+
+// Len returns the number of values of the Matcher
+func (m *Matcher) Len() int {
+	return m.count
+}
+
+// Match returns the values of the patterns matching the topic, a value being returned once per matching pattern it is
+// associated with. The order of the values is unspecified.
+func (m *Matcher) Match(topic string) []interface{} {
+	var values []interface{}
+	match(&m.root, strings.Split(topic, Separator), &values)
+	return values
+}
+
+func match(n *node, levels []string, values *[]interface{}) {
+	// the multi level wildcard also matches the parent level, e.g. a/# matches a
+	if multi, ok := n.children[MultiLevelWildcard]; ok {
+		*values = append(*values, multi.values...)
+	}
+	if len(levels) == 0 {
+		*values = append(*values, n.values...)
+		return
+	}
+	if child, ok := n.children[levels[0]]; ok {
+		match(child, levels[1:], values)
+	}
+	if single, ok := n.children[SingleLevelWildcard]; ok {
+		match(single, levels[1:], values)
+	}
+}
@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package topic
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func matchedStrings(m *Matcher, topic string) []string {
+	var result []string
+	for _, value := range m.Match(topic) {
+		result = append(result, value.(string))
+	}
+	sort.Strings(result)
+	return result
+}
+
+func TestMatcherMatchesLikeMatches(t *testing.T) {
+	for _, testCase := range matchTests {
+		m := NewMatcher()
+		err := m.Add(testCase.pattern, testCase.pattern)
+		if err != nil {
+			assert.False(t, testCase.expected, "the invalid pattern %s matches no topic", testCase.pattern)
+			continue
+		}
+		assert.Equal(t, testCase.expected, len(m.Match(testCase.topic)) == 1, "%s matches %s", testCase.pattern, testCase.topic)
+	}
+}
+
+func TestMatcherManyPatterns(t *testing.T) {
+	m := NewMatcher()
+	patterns := []string{
+		"edgex/events/device/profile1/device1/source1",
+		"edgex/events/device/+/device1/+",
+		"edgex/events/device/profile1/#",
+		"edgex/events/device/+/device2/+",
+		"edgex/events/#",
+		"edgex/commands/#",
+		"#",
+	}
+	for _, pattern := range patterns {
+		require.NoError(t, m.Add(pattern, pattern))
+	}
+	assert.Equal(t, len(patterns), m.Len())
+
+	assert.Equal(t, []string{
+		"#",
+		"edgex/events/#",
+		"edgex/events/device/+/device1/+",
+		"edgex/events/device/profile1/#",
+		"edgex/events/device/profile1/device1/source1",
+	}, matchedStrings(m, "edgex/events/device/profile1/device1/source1"))
+	assert.Equal(t, []string{"#", "edgex/events/#", "edgex/events/device/+/device2/+"}, matchedStrings(m, "edgex/events/device/profile2/device2/source1"))
+	assert.Equal(t, []string{"#", "edgex/commands/#"}, matchedStrings(m, "edgex/commands"))
+	assert.Equal(t, []string{"#"}, matchedStrings(m, "other"))
+}
+
+func TestMatcherValues(t *testing.T) {
+	m := NewMatcher()
+	first, second := &struct{ id int }{1}, &struct{ id int }{2}
+	require.NoError(t, m.Add("a/+", first))
+	require.NoError(t, m.Add("a/+", second))
+	require.NoError(t, m.Add("a/#", first))
+	assert.Len(t, m.Match("a/b"), 3, "a value is returned once per matching pattern")
+
+	assert.True(t, m.Remove("a/+", first))
+	assert.False(t, m.Remove("a/+", first), "the value was already removed")
+	assert.False(t, m.Remove("a/b", second), "the value isn't associated with the pattern")
+	assert.ElementsMatch(t, []interface{}{second, first}, m.Match("a/b"))
+	assert.Equal(t, 2, m.Len())
+}
+
+func TestMatcherPrunes(t *testing.T) {
+	m := NewMatcher()
+	require.NoError(t, m.Add("a/b/c", "abc"))
+	require.NoError(t, m.Add("a/b", "ab"))
+	require.True(t, m.Remove("a/b/c", "abc"))
+	assert.Empty(t, m.root.children["a"].children["b"].children, "the empty levels are removed")
+	require.True(t, m.Remove("a/b", "ab"))
+	assert.Empty(t, m.root.children)
+	assert.Zero(t, m.Len())
+}
+
+func TestMatcherInvalidPattern(t *testing.T) {
+	m := NewMatcher()
+	err := m.Add("a/#/c", "value")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Zero(t, m.Len())
+}
@@ -0,0 +1,165 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package topic builds and parses the message bus topics of the events, <base topic>/<profile>/<device>/<source>,
+// and matches the topics against MQTT-style subscription patterns, in which the "+" level matches any single level and
+// a trailing "#" level matches any number of levels, including none:
+//
+//	t := topic.FromEvent("edgex/events/device", event)
+//	names, err := topic.Parse("edgex/events/device", t)
+//	topic.Matches("edgex/events/device/+/device1/#", t)
+//
+// The Matcher matches a topic against many patterns at once, e.g. the subscriptions of a broker, with a trie of the
+// pattern levels.
+package topic
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const (
+	// Separator separates the levels of a topic
+	Separator = "/"
+	// SingleLevelWildcard is the pattern level matching any single level of a topic
+	SingleLevelWildcard = "+"
+	// MultiLevelWildcard is the last pattern level matching any number of levels of a topic, including none
+	MultiLevelWildcard = "#"
+)
+
+// Names holds the profile, device and source names of the topic of an event
+type Names struct {
+	ProfileName string `validate:"required,edgex-dto-rfc3986-unreserved-chars"`
+	DeviceName  string `validate:"required,edgex-dto-rfc3986-unreserved-chars"`
+	SourceName  string `validate:"required,edgex-dto-rfc3986-unreserved-chars"`
+}
+
+// Escape escapes the name so that it can be used as a single topic level, i.e. without separator nor wildcard. The
+// name is query escaped as the topics have always been, except the spaces which are escaped as %20 instead of the
+// single level wildcard.
+func Escape(name string) string {
+	return strings.ReplaceAll(url.QueryEscape(name), SingleLevelWildcard, "%20")
+}
+
+// Unescape reverts Escape
+func Unescape(level string) (string, errors.EdgeX) {
+	name, err := url.PathUnescape(level)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to unescape the topic level %s", level), err)
+	}
+	return name, nil
+}
+
+// Build returns the topic of the names under the base topic, the names being escaped
+func Build(baseTopic string, names Names) string {
+	return strings.Join([]string{baseTopic, Escape(names.ProfileName), Escape(names.DeviceName), Escape(names.SourceName)}, Separator)
+}
+
+// FromEvent returns the topic of the event under the base topic
+func FromEvent(baseTopic string, event dtos.Event) string {
+	return Build(baseTopic, Names{ProfileName: event.ProfileName, DeviceName: event.DeviceName, SourceName: event.SourceName})
+}
+
+// FromAddEventRequest returns the topic of the event of the request under the base topic
+func FromAddEventRequest(baseTopic string, req requests.AddEventRequest) string {
+	return FromEvent(baseTopic, req.Event)
+}
+
+// Pattern returns the pattern matching the topics of the names under the base topic, the empty names being replaced by
+// the single level wildcard, e.g. Pattern(base, "", "device1", "") matches all the events of device1
+func Pattern(baseTopic string, profileName string, deviceName string, sourceName string) string {
+	levels := []string{baseTopic}
+	for _, name := range []string{profileName, deviceName, sourceName} {
+		if name == "" {
+			levels = append(levels, SingleLevelWildcard)
+		} else {
+			levels = append(levels, Escape(name))
+		}
+	}
+	return strings.Join(levels, Separator)
+}
+
+// Parse returns the names of the topic of an event under the base topic. The names failing the validation of the Event
+// DTO, i.e. the names with other characters than the RFC 3986 unreserved ones, are rejected.
+func Parse(baseTopic string, topic string) (Names, errors.EdgeX) {
+	prefix := baseTopic + Separator
+	if !strings.HasPrefix(topic, prefix) {
+		return Names{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the topic %s isn't under the base topic %s", topic, baseTopic), nil)
+	}
+	levels := strings.Split(strings.TrimPrefix(topic, prefix), Separator)
+	if len(levels) != 3 {
+		return Names{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the topic %s doesn't end with the profile, device and source names", topic), nil)
+	}
+
+	names := make([]string, len(levels))
+	for i, level := range levels {
+		name, err := Unescape(level)
+		if err != nil {
+			return Names{}, errors.NewCommonEdgeXWrapper(err)
+		}
+		names[i] = name
+	}
+	result := Names{ProfileName: names[0], DeviceName: names[1], SourceName: names[2]}
+	if err := common.Validate(result); err != nil {
+		return Names{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid names in the topic %s", topic), err)
+	}
+	return result, nil
+}
+
+// ValidatePattern checks that the wildcards of the pattern occupy a whole level, and that the multi level wildcard is
+// the last level
+func ValidatePattern(pattern string) errors.EdgeX {
+	if pattern == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the pattern is empty", nil)
+	}
+	levels := strings.Split(pattern, Separator)
+	for i, level := range levels {
+		switch {
+		case level == MultiLevelWildcard && i != len(levels)-1:
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the %s wildcard isn't the last level of the pattern %s", MultiLevelWildcard, pattern), nil)
+		case level != MultiLevelWildcard && level != SingleLevelWildcard && strings.ContainsAny(level, SingleLevelWildcard+MultiLevelWildcard):
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("a wildcard doesn't occupy a whole level of the pattern %s", pattern), nil)
+		}
+	}
+	return nil
+}
+
+// ValidateTopic checks that the topic, which a message is published on, is neither empty nor contains wildcards
+func ValidateTopic(topic string) errors.EdgeX {
+	if topic == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the topic is empty", nil)
+	}
+	if strings.ContainsAny(topic, SingleLevelWildcard+MultiLevelWildcard) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the topic %s contains wildcards", topic), nil)
+	}
+	return nil
+}
+
+// Matches reports whether the topic matches the pattern, an invalid pattern matching no topic. Use a Matcher to match
+// a topic against many patterns.
+func Matches(pattern string, topic string) bool {
+	if ValidatePattern(pattern) != nil {
+		return false
+	}
+	patternLevels := strings.Split(pattern, Separator)
+	topicLevels := strings.Split(topic, Separator)
+	for i, level := range patternLevels {
+		switch {
+		case level == MultiLevelWildcard:
+			return true
+		case i >= len(topicLevels):
+			return false
+		case level != SingleLevelWildcard && level != topicLevels[i]:
+			return false
+		}
+	}
+	return len(patternLevels) == len(topicLevels)
+}
@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package topic
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBaseTopic = "edgex/events/device"
+
+func TestBuild(t *testing.T) {
+	event := dtos.NewEvent("profile1", "device1", "source1")
+	assert.Equal(t, "edgex/events/device/profile1/device1/source1", FromEvent(testBaseTopic, event))
+	assert.Equal(t, "edgex/events/device/profile1/device1/source1", FromAddEventRequest(testBaseTopic, requests.NewAddEventRequest(event)))
+	assert.Equal(t, "base/a%2Fb/c%2Bd/e%23f%20g", Build("base", Names{ProfileName: "a/b", DeviceName: "c+d", SourceName: "e#f g"}),
+		"the separator and the wildcards are escaped")
+	assert.Equal(t, "base/a%3Ab/c%40d/e%26f", Build("base", Names{ProfileName: "a:b", DeviceName: "c@d", SourceName: "e&f"}),
+		"the names are query escaped")
+}
+
+func TestPattern(t *testing.T) {
+	assert.Equal(t, "base/+/device1/+", Pattern("base", "", "device1", ""))
+	assert.Equal(t, "base/profile1/device%2F1/source1", Pattern("base", "profile1", "device/1", "source1"))
+	assert.True(t, Matches(Pattern(testBaseTopic, "", "device1", ""), FromEvent(testBaseTopic, dtos.NewEvent("profile1", "device1", "source1"))))
+}
+
+func TestParse(t *testing.T) {
+	names, err := Parse(testBaseTopic, "edgex/events/device/profile-1/device_1/source~1")
+	require.NoError(t, err)
+	assert.Equal(t, Names{ProfileName: "profile-1", DeviceName: "device_1", SourceName: "source~1"}, names)
+
+	names, err = Parse(testBaseTopic, FromEvent(testBaseTopic, dtos.NewEvent("profile1", "device1", "source1")))
+	require.NoError(t, err)
+	assert.Equal(t, Names{ProfileName: "profile1", DeviceName: "device1", SourceName: "source1"}, names, "Parse reverts Build")
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		topic string
+	}{
+		{"other base topic", "edgex/events/core/profile1/device1/source1"},
+		{"base topic prefix", "edgex/events/devices/profile1/device1/source1"},
+		{"missing level", "edgex/events/device/profile1/device1"},
+		{"extra level", "edgex/events/device/profile1/device1/source1/extra"},
+		{"empty name", "edgex/events/device/profile1//source1"},
+		{"reserved character", "edgex/events/device/profile1/device.1/source1"},
+		{"escaped reserved character", "edgex/events/device/profile1/device%2F1/source1"},
+		{"invalid escape", "edgex/events/device/profile1/device%zz/source1"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Parse(testBaseTopic, testCase.topic)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
+
+func TestValidatePattern(t *testing.T) {
+	valid := []string{"a", "a/b", "+", "#", "a/+/c", "a/#", "+/+/#", "a//b"}
+	for _, pattern := range valid {
+		assert.NoError(t, ValidatePattern(pattern), pattern)
+	}
+	invalid := []string{"", "a/#/c", "#/a", "a/b+", "a/#b", "a+/b"}
+	for _, pattern := range invalid {
+		err := ValidatePattern(pattern)
+		require.Error(t, err, pattern)
+		assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	}
+}
+
+func TestValidateTopic(t *testing.T) {
+	assert.NoError(t, ValidateTopic("a/b/c"))
+	assert.Error(t, ValidateTopic(""))
+	assert.Error(t, ValidateTopic("a/+/c"))
+	assert.Error(t, ValidateTopic("a/#"))
+}
+
+// matchTests are shared by the tests of Matches and of the Matcher
+var matchTests = []struct {
+	pattern  string
+	topic    string
+	expected bool
+}{
+	{"a/b/c", "a/b/c", true},
+	{"a/b/c", "a/b", false},
+	{"a/b", "a/b/c", false},
+	{"a/+/c", "a/b/c", true},
+	{"a/+/c", "a/b/d", false},
+	{"a/+/c", "a/c", false},
+	{"a/+", "a/", true},
+	{"+/+", "a/b", true},
+	{"+", "a/b", false},
+	{"a/#", "a", true},
+	{"a/#", "a/b", true},
+	{"a/#", "a/b/c", true},
+	{"a/#", "b/a", false},
+	{"#", "a/b/c", true},
+	{"a/+/#", "a/b", true},
+	{"a/+/#", "a", false},
+	{"a/b/#", "a/c/b", false},
+	{"a/#/c", "a/b/c", false},
+}
+
+func TestMatches(t *testing.T) {
+	for _, testCase := range matchTests {
+		assert.Equal(t, testCase.expected, Matches(testCase.pattern, testCase.topic), "%s matches %s", testCase.pattern, testCase.topic)
+	}
+}
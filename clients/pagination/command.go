@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// DeviceCoreCommandIterator iterates over the device core commands returned by a paginated list method
+type DeviceCoreCommandIterator struct {
+	pager
+	page []dtos.DeviceCoreCommand
+}
+
+func newDeviceCoreCommandIterator(pageSize int, list func(ctx context.Context, offset int, limit int) (responses.MultiDeviceCoreCommandsResponse, errors.EdgeX)) *DeviceCoreCommandIterator {
+	it := &DeviceCoreCommandIterator{}
+	it.pager = newPager(pageSize, func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		res, err := list(ctx, offset, limit)
+		if err != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(err)
+		}
+		it.page = res.DeviceCoreCommands
+		return len(res.DeviceCoreCommands), res.TotalCount, nil
+	})
+	return it
+}
+
+// DeviceCoreCommand returns the current device core command, it must be called after Next returned true
+func (it *DeviceCoreCommandIterator) DeviceCoreCommand() dtos.DeviceCoreCommand {
+	return it.page[it.index]
+}
+
+// AllDeviceCoreCommands iterates over the device core commands returned by CommandClient.AllDeviceCoreCommands
+func AllDeviceCoreCommands(client interfaces.CommandClient, pageSize int) *DeviceCoreCommandIterator {
+	return newDeviceCoreCommandIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiDeviceCoreCommandsResponse, errors.EdgeX) {
+		return client.AllDeviceCoreCommands(ctx, offset, limit)
+	})
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// DeviceIterator iterates over the devices returned by a paginated list method
+type DeviceIterator struct {
+	pager
+	page []dtos.Device
+}
+
+func newDeviceIterator(pageSize int, list func(ctx context.Context, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX)) *DeviceIterator {
+	it := &DeviceIterator{}
+	it.pager = newPager(pageSize, func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		res, err := list(ctx, offset, limit)
+		if err != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(err)
+		}
+		it.page = res.Devices
+		return len(res.Devices), res.TotalCount, nil
+	})
+	return it
+}
+
+// Device returns the current device, it must be called after Next returned true
+func (it *DeviceIterator) Device() dtos.Device {
+	return it.page[it.index]
+}
+
+// AllDevices iterates over the devices returned by DeviceClient.AllDevices
+func AllDevices(client interfaces.DeviceClient, labels []string, pageSize int) *DeviceIterator {
+	return newDeviceIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+		return client.AllDevices(ctx, labels, offset, limit)
+	})
+}
+
+// DevicesByProfileName iterates over the devices returned by DeviceClient.DevicesByProfileName
+func DevicesByProfileName(client interfaces.DeviceClient, name string, pageSize int) *DeviceIterator {
+	return newDeviceIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+		return client.DevicesByProfileName(ctx, name, offset, limit)
+	})
+}
+
+// DevicesByServiceName iterates over the devices returned by DeviceClient.DevicesByServiceName
+func DevicesByServiceName(client interfaces.DeviceClient, name string, pageSize int) *DeviceIterator {
+	return newDeviceIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+		return client.DevicesByServiceName(ctx, name, offset, limit)
+	})
+}
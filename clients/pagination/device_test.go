@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func multiDevicesResponse(totalCount uint32, names ...string) responses.MultiDevicesResponse {
+	res := responses.MultiDevicesResponse{
+		BaseWithTotalCountResponse: dtoCommon.NewBaseWithTotalCountResponse("", "", 200, totalCount),
+	}
+	for _, name := range names {
+		res.Devices = append(res.Devices, dtos.Device{Name: name})
+	}
+	return res
+}
+
+func TestAllDevices(t *testing.T) {
+	labels := []string{"label"}
+	client := &mocks.DeviceClient{}
+	client.On("AllDevices", mock.Anything, labels, 0, 2).Return(multiDevicesResponse(3, "a", "b"), nil).Once()
+	client.On("AllDevices", mock.Anything, labels, 2, 2).Return(multiDevicesResponse(3, "c"), nil).Once()
+
+	var names []string
+	it := AllDevices(client, labels, 2)
+	for it.Next(context.Background()) {
+		names = append(names, it.Device().Name)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+	client.AssertExpectations(t)
+}
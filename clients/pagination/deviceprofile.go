@@ -0,0 +1,67 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// DeviceProfileIterator iterates over the device profiles returned by a paginated list method
+type DeviceProfileIterator struct {
+	pager
+	page []dtos.DeviceProfile
+}
+
+func newDeviceProfileIterator(pageSize int, list func(ctx context.Context, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX)) *DeviceProfileIterator {
+	it := &DeviceProfileIterator{}
+	it.pager = newPager(pageSize, func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		res, err := list(ctx, offset, limit)
+		if err != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(err)
+		}
+		it.page = res.Profiles
+		return len(res.Profiles), res.TotalCount, nil
+	})
+	return it
+}
+
+// DeviceProfile returns the current device profile, it must be called after Next returned true
+func (it *DeviceProfileIterator) DeviceProfile() dtos.DeviceProfile {
+	return it.page[it.index]
+}
+
+// AllDeviceProfiles iterates over the device profiles returned by DeviceProfileClient.AllDeviceProfiles
+func AllDeviceProfiles(client interfaces.DeviceProfileClient, labels []string, pageSize int) *DeviceProfileIterator {
+	return newDeviceProfileIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
+		return client.AllDeviceProfiles(ctx, labels, offset, limit)
+	})
+}
+
+// DeviceProfilesByModel iterates over the device profiles returned by DeviceProfileClient.DeviceProfilesByModel
+func DeviceProfilesByModel(client interfaces.DeviceProfileClient, model string, pageSize int) *DeviceProfileIterator {
+	return newDeviceProfileIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
+		return client.DeviceProfilesByModel(ctx, model, offset, limit)
+	})
+}
+
+// DeviceProfilesByManufacturer iterates over the device profiles returned by DeviceProfileClient.DeviceProfilesByManufacturer
+func DeviceProfilesByManufacturer(client interfaces.DeviceProfileClient, manufacturer string, pageSize int) *DeviceProfileIterator {
+	return newDeviceProfileIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
+		return client.DeviceProfilesByManufacturer(ctx, manufacturer, offset, limit)
+	})
+}
+
+// DeviceProfilesByManufacturerAndModel iterates over the device profiles returned by DeviceProfileClient.DeviceProfilesByManufacturerAndModel
+func DeviceProfilesByManufacturerAndModel(client interfaces.DeviceProfileClient, manufacturer string, model string, pageSize int) *DeviceProfileIterator {
+	return newDeviceProfileIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
+		return client.DeviceProfilesByManufacturerAndModel(ctx, manufacturer, model, offset, limit)
+	})
+}
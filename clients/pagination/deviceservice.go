@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// DeviceServiceIterator iterates over the device services returned by a paginated list method
+type DeviceServiceIterator struct {
+	pager
+	page []dtos.DeviceService
+}
+
+func newDeviceServiceIterator(pageSize int, list func(ctx context.Context, offset int, limit int) (responses.MultiDeviceServicesResponse, errors.EdgeX)) *DeviceServiceIterator {
+	it := &DeviceServiceIterator{}
+	it.pager = newPager(pageSize, func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		res, err := list(ctx, offset, limit)
+		if err != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(err)
+		}
+		it.page = res.Services
+		return len(res.Services), res.TotalCount, nil
+	})
+	return it
+}
+
+// DeviceService returns the current device service, it must be called after Next returned true
+func (it *DeviceServiceIterator) DeviceService() dtos.DeviceService {
+	return it.page[it.index]
+}
+
+// AllDeviceServices iterates over the device services returned by DeviceServiceClient.AllDeviceServices
+func AllDeviceServices(client interfaces.DeviceServiceClient, labels []string, pageSize int) *DeviceServiceIterator {
+	return newDeviceServiceIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiDeviceServicesResponse, errors.EdgeX) {
+		return client.AllDeviceServices(ctx, labels, offset, limit)
+	})
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// EventIterator iterates over the events returned by a paginated list method
+type EventIterator struct {
+	pager
+	page []dtos.Event
+}
+
+func newEventIterator(pageSize int, list func(ctx context.Context, offset int, limit int) (responses.MultiEventsResponse, errors.EdgeX)) *EventIterator {
+	it := &EventIterator{}
+	it.pager = newPager(pageSize, func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		res, err := list(ctx, offset, limit)
+		if err != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(err)
+		}
+		it.page = res.Events
+		return len(res.Events), res.TotalCount, nil
+	})
+	return it
+}
+
+// Event returns the current event, it must be called after Next returned true
+func (it *EventIterator) Event() dtos.Event {
+	return it.page[it.index]
+}
+
+// AllEvents iterates over the events returned by EventClient.AllEvents
+func AllEvents(client interfaces.EventClient, pageSize int) *EventIterator {
+	return newEventIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiEventsResponse, errors.EdgeX) {
+		return client.AllEvents(ctx, offset, limit)
+	})
+}
+
+// EventsByDeviceName iterates over the events returned by EventClient.EventsByDeviceName
+func EventsByDeviceName(client interfaces.EventClient, name string, pageSize int) *EventIterator {
+	return newEventIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiEventsResponse, errors.EdgeX) {
+		return client.EventsByDeviceName(ctx, name, offset, limit)
+	})
+}
+
+// EventsByTimeRange iterates over the events returned by EventClient.EventsByTimeRange
+func EventsByTimeRange(client interfaces.EventClient, start int, end int, pageSize int) *EventIterator {
+	return newEventIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiEventsResponse, errors.EdgeX) {
+		return client.EventsByTimeRange(ctx, start, end, offset, limit)
+	})
+}
@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// IntervalIterator iterates over the intervals returned by a paginated list method
+type IntervalIterator struct {
+	pager
+	page []dtos.Interval
+}
+
+func newIntervalIterator(pageSize int, list func(ctx context.Context, offset int, limit int) (responses.MultiIntervalsResponse, errors.EdgeX)) *IntervalIterator {
+	it := &IntervalIterator{}
+	it.pager = newPager(pageSize, func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		res, err := list(ctx, offset, limit)
+		if err != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(err)
+		}
+		it.page = res.Intervals
+		return len(res.Intervals), res.TotalCount, nil
+	})
+	return it
+}
+
+// Interval returns the current interval, it must be called after Next returned true
+func (it *IntervalIterator) Interval() dtos.Interval {
+	return it.page[it.index]
+}
+
+// AllIntervals iterates over the intervals returned by IntervalClient.AllIntervals
+func AllIntervals(client interfaces.IntervalClient, pageSize int) *IntervalIterator {
+	return newIntervalIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiIntervalsResponse, errors.EdgeX) {
+		return client.AllIntervals(ctx, offset, limit)
+	})
+}
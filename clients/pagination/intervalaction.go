@@ -0,0 +1,46 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// IntervalActionIterator iterates over the interval actions returned by a paginated list method
+type IntervalActionIterator struct {
+	pager
+	page []dtos.IntervalAction
+}
+
+func newIntervalActionIterator(pageSize int, list func(ctx context.Context, offset int, limit int) (responses.MultiIntervalActionsResponse, errors.EdgeX)) *IntervalActionIterator {
+	it := &IntervalActionIterator{}
+	it.pager = newPager(pageSize, func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		res, err := list(ctx, offset, limit)
+		if err != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(err)
+		}
+		it.page = res.Actions
+		return len(res.Actions), res.TotalCount, nil
+	})
+	return it
+}
+
+// IntervalAction returns the current interval action, it must be called after Next returned true
+func (it *IntervalActionIterator) IntervalAction() dtos.IntervalAction {
+	return it.page[it.index]
+}
+
+// AllIntervalActions iterates over the interval actions returned by IntervalActionClient.AllIntervalActions
+func AllIntervalActions(client interfaces.IntervalActionClient, pageSize int) *IntervalActionIterator {
+	return newIntervalActionIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiIntervalActionsResponse, errors.EdgeX) {
+		return client.AllIntervalActions(ctx, offset, limit)
+	})
+}
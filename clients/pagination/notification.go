@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// NotificationIterator iterates over the notifications returned by a paginated list method
+type NotificationIterator struct {
+	pager
+	page []dtos.Notification
+}
+
+func newNotificationIterator(pageSize int, list func(ctx context.Context, offset int, limit int) (responses.MultiNotificationsResponse, errors.EdgeX)) *NotificationIterator {
+	it := &NotificationIterator{}
+	it.pager = newPager(pageSize, func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		res, err := list(ctx, offset, limit)
+		if err != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(err)
+		}
+		it.page = res.Notifications
+		return len(res.Notifications), res.TotalCount, nil
+	})
+	return it
+}
+
+// Notification returns the current notification, it must be called after Next returned true
+func (it *NotificationIterator) Notification() dtos.Notification {
+	return it.page[it.index]
+}
+
+// NotificationsByCategory iterates over the notifications returned by NotificationClient.NotificationsByCategory
+func NotificationsByCategory(client interfaces.NotificationClient, category string, pageSize int) *NotificationIterator {
+	return newNotificationIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiNotificationsResponse, errors.EdgeX) {
+		return client.NotificationsByCategory(ctx, category, offset, limit)
+	})
+}
+
+// NotificationsByLabel iterates over the notifications returned by NotificationClient.NotificationsByLabel
+func NotificationsByLabel(client interfaces.NotificationClient, label string, pageSize int) *NotificationIterator {
+	return newNotificationIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiNotificationsResponse, errors.EdgeX) {
+		return client.NotificationsByLabel(ctx, label, offset, limit)
+	})
+}
+
+// NotificationsByStatus iterates over the notifications returned by NotificationClient.NotificationsByStatus
+func NotificationsByStatus(client interfaces.NotificationClient, status string, pageSize int) *NotificationIterator {
+	return newNotificationIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiNotificationsResponse, errors.EdgeX) {
+		return client.NotificationsByStatus(ctx, status, offset, limit)
+	})
+}
+
+// NotificationsByTimeRange iterates over the notifications returned by NotificationClient.NotificationsByTimeRange
+func NotificationsByTimeRange(client interfaces.NotificationClient, start int, end int, pageSize int) *NotificationIterator {
+	return newNotificationIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiNotificationsResponse, errors.EdgeX) {
+		return client.NotificationsByTimeRange(ctx, start, end, offset, limit)
+	})
+}
+
+// NotificationsBySubscriptionName iterates over the notifications returned by NotificationClient.NotificationsBySubscriptionName
+func NotificationsBySubscriptionName(client interfaces.NotificationClient, subscriptionName string, pageSize int) *NotificationIterator {
+	return newNotificationIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiNotificationsResponse, errors.EdgeX) {
+		return client.NotificationsBySubscriptionName(ctx, subscriptionName, offset, limit)
+	})
+}
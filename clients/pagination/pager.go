@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pagination provides iterators walking through all the items of the paginated list methods of the clients.
+// The iterators fetch the pages lazily, one page at a time, and stop once the totalCount reported by the service has
+// been reached. Since the pages are fetched with offset and limit, the items added or removed during the iteration
+// might be skipped or returned twice.
+package pagination
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// pageFunc fetches the page starting at offset with at most limit items, it returns the number of items of the page
+// and the total count of the items.
+type pageFunc func(ctx context.Context, offset int, limit int) (count int, totalCount uint32, err errors.EdgeX)
+
+// pager keeps track of the position of an iterator
+type pager struct {
+	fetch    pageFunc
+	pageSize int
+	offset   int
+	// index is the position of the current item within the current page, and count is the size of the current page
+	index int
+	count int
+	last  bool
+	err   errors.EdgeX
+}
+
+func newPager(pageSize int, fetch pageFunc) pager {
+	if pageSize <= 0 {
+		pageSize = common.DefaultLimit
+	}
+	return pager{fetch: fetch, pageSize: pageSize, index: -1}
+}
+
+// Next advances the iterator to the next item, fetching the next page if needed. It returns false when all the items
+// have been visited, the context is done or a page can't be fetched, in which case Err returns the error.
+func (p *pager) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+	if p.index+1 < p.count {
+		p.index++
+		return true
+	}
+	if p.last {
+		return false
+	}
+	if err := ctx.Err(); err != nil {
+		p.err = errors.NewCommonEdgeX(errors.KindServerError, "the iteration is canceled", err)
+		return false
+	}
+
+	count, totalCount, err := p.fetch(ctx, p.offset, p.pageSize)
+	if err != nil {
+		p.err = errors.NewCommonEdgeXWrapper(err)
+		return false
+	}
+	p.offset += count
+	p.index = 0
+	p.count = count
+	p.last = count == 0 || p.offset >= int(totalCount)
+	return count > 0
+}
+
+// Err returns the error that stopped the iteration, if any
+func (p *pager) Err() errors.EdgeX {
+	return p.err
+}
@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagesOf returns a pageFunc serving total items in pages of at most maxLimit items, and records the requested offsets
+func pagesOf(total int, maxLimit int, offsets *[]int) pageFunc {
+	return func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		*offsets = append(*offsets, offset)
+		if limit > maxLimit {
+			limit = maxLimit
+		}
+		count := total - offset
+		if count > limit {
+			count = limit
+		}
+		if count < 0 {
+			count = 0
+		}
+		return count, uint32(total), nil
+	}
+}
+
+func TestPager(t *testing.T) {
+	tests := []struct {
+		name            string
+		total           int
+		pageSize        int
+		maxLimit        int
+		expectedOffsets []int
+	}{
+		{"empty", 0, 10, 100, []int{0}},
+		{"single page", 5, 10, 100, []int{0}},
+		{"exact pages", 20, 10, 100, []int{0, 10}},
+		{"partial last page", 25, 10, 100, []int{0, 10, 20}},
+		{"limited by the service", 25, 10, 8, []int{0, 8, 16, 24}},
+		{"default page size", 30, 0, 100, []int{0, common.DefaultLimit}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var offsets []int
+			p := newPager(testCase.pageSize, pagesOf(testCase.total, testCase.maxLimit, &offsets))
+			visited := 0
+			for p.Next(context.Background()) {
+				visited++
+			}
+			require.NoError(t, p.Err())
+			assert.Equal(t, testCase.total, visited)
+			assert.Equal(t, testCase.expectedOffsets, offsets)
+			assert.False(t, p.Next(context.Background()))
+		})
+	}
+}
+
+func TestPagerError(t *testing.T) {
+	p := newPager(10, func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		if offset > 0 {
+			return 0, 0, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "unavailable", nil)
+		}
+		return 10, 30, nil
+	})
+	visited := 0
+	for p.Next(context.Background()) {
+		visited++
+	}
+	assert.Equal(t, 10, visited)
+	require.Error(t, p.Err())
+	assert.Equal(t, errors.KindServiceUnavailable, errors.Kind(p.Err()))
+}
+
+func TestPagerCanceled(t *testing.T) {
+	var offsets []int
+	p := newPager(10, pagesOf(30, 100, &offsets))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	visited := 0
+	for p.Next(ctx) {
+		visited++
+		if visited == 5 {
+			cancel()
+		}
+	}
+	assert.Equal(t, 10, visited, "the current page should be consumed before the cancellation is noticed")
+	assert.Equal(t, []int{0}, offsets)
+	require.Error(t, p.Err())
+}
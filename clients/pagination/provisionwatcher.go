@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// ProvisionWatcherIterator iterates over the provision watchers returned by a paginated list method
+type ProvisionWatcherIterator struct {
+	pager
+	page []dtos.ProvisionWatcher
+}
+
+func newProvisionWatcherIterator(pageSize int, list func(ctx context.Context, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX)) *ProvisionWatcherIterator {
+	it := &ProvisionWatcherIterator{}
+	it.pager = newPager(pageSize, func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		res, err := list(ctx, offset, limit)
+		if err != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(err)
+		}
+		it.page = res.ProvisionWatchers
+		return len(res.ProvisionWatchers), res.TotalCount, nil
+	})
+	return it
+}
+
+// ProvisionWatcher returns the current provision watcher, it must be called after Next returned true
+func (it *ProvisionWatcherIterator) ProvisionWatcher() dtos.ProvisionWatcher {
+	return it.page[it.index]
+}
+
+// AllProvisionWatchers iterates over the provision watchers returned by ProvisionWatcherClient.AllProvisionWatchers
+func AllProvisionWatchers(client interfaces.ProvisionWatcherClient, labels []string, pageSize int) *ProvisionWatcherIterator {
+	return newProvisionWatcherIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX) {
+		return client.AllProvisionWatchers(ctx, labels, offset, limit)
+	})
+}
+
+// ProvisionWatchersByProfileName iterates over the provision watchers returned by ProvisionWatcherClient.ProvisionWatchersByProfileName
+func ProvisionWatchersByProfileName(client interfaces.ProvisionWatcherClient, name string, pageSize int) *ProvisionWatcherIterator {
+	return newProvisionWatcherIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX) {
+		return client.ProvisionWatchersByProfileName(ctx, name, offset, limit)
+	})
+}
+
+// ProvisionWatchersByServiceName iterates over the provision watchers returned by ProvisionWatcherClient.ProvisionWatchersByServiceName
+func ProvisionWatchersByServiceName(client interfaces.ProvisionWatcherClient, name string, pageSize int) *ProvisionWatcherIterator {
+	return newProvisionWatcherIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiProvisionWatchersResponse, errors.EdgeX) {
+		return client.ProvisionWatchersByServiceName(ctx, name, offset, limit)
+	})
+}
@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// ReadingIterator iterates over the readings returned by a paginated list method
+type ReadingIterator struct {
+	pager
+	page []dtos.BaseReading
+}
+
+func newReadingIterator(pageSize int, list func(ctx context.Context, offset int, limit int) (responses.MultiReadingsResponse, errors.EdgeX)) *ReadingIterator {
+	it := &ReadingIterator{}
+	it.pager = newPager(pageSize, func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		res, err := list(ctx, offset, limit)
+		if err != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(err)
+		}
+		it.page = res.Readings
+		return len(res.Readings), res.TotalCount, nil
+	})
+	return it
+}
+
+// Reading returns the current reading, it must be called after Next returned true
+func (it *ReadingIterator) Reading() dtos.BaseReading {
+	return it.page[it.index]
+}
+
+// AllReadings iterates over the readings returned by ReadingClient.AllReadings
+func AllReadings(client interfaces.ReadingClient, pageSize int) *ReadingIterator {
+	return newReadingIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+		return client.AllReadings(ctx, offset, limit)
+	})
+}
+
+// ReadingsByDeviceName iterates over the readings returned by ReadingClient.ReadingsByDeviceName
+func ReadingsByDeviceName(client interfaces.ReadingClient, name string, pageSize int) *ReadingIterator {
+	return newReadingIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+		return client.ReadingsByDeviceName(ctx, name, offset, limit)
+	})
+}
+
+// ReadingsByResourceName iterates over the readings returned by ReadingClient.ReadingsByResourceName
+func ReadingsByResourceName(client interfaces.ReadingClient, name string, pageSize int) *ReadingIterator {
+	return newReadingIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+		return client.ReadingsByResourceName(ctx, name, offset, limit)
+	})
+}
+
+// ReadingsByTimeRange iterates over the readings returned by ReadingClient.ReadingsByTimeRange
+func ReadingsByTimeRange(client interfaces.ReadingClient, start int, end int, pageSize int) *ReadingIterator {
+	return newReadingIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+		return client.ReadingsByTimeRange(ctx, start, end, offset, limit)
+	})
+}
+
+// ReadingsByResourceNameAndTimeRange iterates over the readings returned by ReadingClient.ReadingsByResourceNameAndTimeRange
+func ReadingsByResourceNameAndTimeRange(client interfaces.ReadingClient, name string, start int, end int, pageSize int) *ReadingIterator {
+	return newReadingIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+		return client.ReadingsByResourceNameAndTimeRange(ctx, name, start, end, offset, limit)
+	})
+}
+
+// ReadingsByDeviceNameAndResourceName iterates over the readings returned by ReadingClient.ReadingsByDeviceNameAndResourceName
+func ReadingsByDeviceNameAndResourceName(client interfaces.ReadingClient, deviceName string, resourceName string, pageSize int) *ReadingIterator {
+	return newReadingIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+		return client.ReadingsByDeviceNameAndResourceName(ctx, deviceName, resourceName, offset, limit)
+	})
+}
+
+// ReadingsByDeviceNameAndResourceNameAndTimeRange iterates over the readings returned by ReadingClient.ReadingsByDeviceNameAndResourceNameAndTimeRange
+func ReadingsByDeviceNameAndResourceNameAndTimeRange(client interfaces.ReadingClient, deviceName string, resourceName string, start int, end int, pageSize int) *ReadingIterator {
+	return newReadingIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+		return client.ReadingsByDeviceNameAndResourceNameAndTimeRange(ctx, deviceName, resourceName, start, end, offset, limit)
+	})
+}
+
+// ReadingsByDeviceNameAndResourceNamesAndTimeRange iterates over the readings returned by ReadingClient.ReadingsByDeviceNameAndResourceNamesAndTimeRange
+func ReadingsByDeviceNameAndResourceNamesAndTimeRange(client interfaces.ReadingClient, deviceName string, resourceNames []string, start int, end int, pageSize int) *ReadingIterator {
+	return newReadingIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
+		return client.ReadingsByDeviceNameAndResourceNamesAndTimeRange(ctx, deviceName, resourceNames, start, end, offset, limit)
+	})
+}
@@ -0,0 +1,67 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// SubscriptionIterator iterates over the subscriptions returned by a paginated list method
+type SubscriptionIterator struct {
+	pager
+	page []dtos.Subscription
+}
+
+func newSubscriptionIterator(pageSize int, list func(ctx context.Context, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX)) *SubscriptionIterator {
+	it := &SubscriptionIterator{}
+	it.pager = newPager(pageSize, func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		res, err := list(ctx, offset, limit)
+		if err != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(err)
+		}
+		it.page = res.Subscriptions
+		return len(res.Subscriptions), res.TotalCount, nil
+	})
+	return it
+}
+
+// Subscription returns the current subscription, it must be called after Next returned true
+func (it *SubscriptionIterator) Subscription() dtos.Subscription {
+	return it.page[it.index]
+}
+
+// AllSubscriptions iterates over the subscriptions returned by SubscriptionClient.AllSubscriptions
+func AllSubscriptions(client interfaces.SubscriptionClient, pageSize int) *SubscriptionIterator {
+	return newSubscriptionIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX) {
+		return client.AllSubscriptions(ctx, offset, limit)
+	})
+}
+
+// SubscriptionsByCategory iterates over the subscriptions returned by SubscriptionClient.SubscriptionsByCategory
+func SubscriptionsByCategory(client interfaces.SubscriptionClient, category string, pageSize int) *SubscriptionIterator {
+	return newSubscriptionIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX) {
+		return client.SubscriptionsByCategory(ctx, category, offset, limit)
+	})
+}
+
+// SubscriptionsByLabel iterates over the subscriptions returned by SubscriptionClient.SubscriptionsByLabel
+func SubscriptionsByLabel(client interfaces.SubscriptionClient, label string, pageSize int) *SubscriptionIterator {
+	return newSubscriptionIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX) {
+		return client.SubscriptionsByLabel(ctx, label, offset, limit)
+	})
+}
+
+// SubscriptionsByReceiver iterates over the subscriptions returned by SubscriptionClient.SubscriptionsByReceiver
+func SubscriptionsByReceiver(client interfaces.SubscriptionClient, receiver string, pageSize int) *SubscriptionIterator {
+	return newSubscriptionIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX) {
+		return client.SubscriptionsByReceiver(ctx, receiver, offset, limit)
+	})
+}
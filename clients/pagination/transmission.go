@@ -0,0 +1,74 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pagination
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// TransmissionIterator iterates over the transmissions returned by a paginated list method
+type TransmissionIterator struct {
+	pager
+	page []dtos.Transmission
+}
+
+func newTransmissionIterator(pageSize int, list func(ctx context.Context, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX)) *TransmissionIterator {
+	it := &TransmissionIterator{}
+	it.pager = newPager(pageSize, func(ctx context.Context, offset int, limit int) (int, uint32, errors.EdgeX) {
+		res, err := list(ctx, offset, limit)
+		if err != nil {
+			return 0, 0, errors.NewCommonEdgeXWrapper(err)
+		}
+		it.page = res.Transmissions
+		return len(res.Transmissions), res.TotalCount, nil
+	})
+	return it
+}
+
+// Transmission returns the current transmission, it must be called after Next returned true
+func (it *TransmissionIterator) Transmission() dtos.Transmission {
+	return it.page[it.index]
+}
+
+// TransmissionsByTimeRange iterates over the transmissions returned by TransmissionClient.TransmissionsByTimeRange
+func TransmissionsByTimeRange(client interfaces.TransmissionClient, start int, end int, pageSize int) *TransmissionIterator {
+	return newTransmissionIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX) {
+		return client.TransmissionsByTimeRange(ctx, start, end, offset, limit)
+	})
+}
+
+// AllTransmissions iterates over the transmissions returned by TransmissionClient.AllTransmissions
+func AllTransmissions(client interfaces.TransmissionClient, pageSize int) *TransmissionIterator {
+	return newTransmissionIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX) {
+		return client.AllTransmissions(ctx, offset, limit)
+	})
+}
+
+// TransmissionsByStatus iterates over the transmissions returned by TransmissionClient.TransmissionsByStatus
+func TransmissionsByStatus(client interfaces.TransmissionClient, status string, pageSize int) *TransmissionIterator {
+	return newTransmissionIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX) {
+		return client.TransmissionsByStatus(ctx, status, offset, limit)
+	})
+}
+
+// TransmissionsBySubscriptionName iterates over the transmissions returned by TransmissionClient.TransmissionsBySubscriptionName
+func TransmissionsBySubscriptionName(client interfaces.TransmissionClient, subscriptionName string, pageSize int) *TransmissionIterator {
+	return newTransmissionIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX) {
+		return client.TransmissionsBySubscriptionName(ctx, subscriptionName, offset, limit)
+	})
+}
+
+// TransmissionsByNotificationId iterates over the transmissions returned by TransmissionClient.TransmissionsByNotificationId
+func TransmissionsByNotificationId(client interfaces.TransmissionClient, id string, pageSize int) *TransmissionIterator {
+	return newTransmissionIterator(pageSize, func(ctx context.Context, offset int, limit int) (responses.MultiTransmissionsResponse, errors.EdgeX) {
+		return client.TransmissionsByNotificationId(ctx, id, offset, limit)
+	})
+}
@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package refserver
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// serviceVersion is the version of the API specification implemented by the Server
+const serviceVersion = "2.1.0"
+
+func (s *Server) addCommonRoutes() {
+	s.router.add(http.MethodGet, common.ApiPingRoute, http.StatusOK, func(*http.Request, map[string]string) (interface{}, errors.EdgeX) {
+		return dtoCommon.NewPingResponse(), nil
+	})
+	s.router.add(http.MethodGet, common.ApiVersionRoute, http.StatusOK, func(*http.Request, map[string]string) (interface{}, errors.EdgeX) {
+		return dtoCommon.NewVersionResponse(serviceVersion), nil
+	})
+	s.router.add(http.MethodGet, common.ApiConfigRoute, http.StatusOK, func(*http.Request, map[string]string) (interface{}, errors.EdgeX) {
+		return dtoCommon.NewConfigResponse(map[string]interface{}{}), nil
+	})
+	s.router.add(http.MethodGet, common.ApiMetricsRoute, http.StatusOK, func(*http.Request, map[string]string) (interface{}, errors.EdgeX) {
+		return dtoCommon.NewMetricsResponse(dtoCommon.Metrics{}), nil
+	})
+	s.router.add(http.MethodPost, common.ApiSecretRoute, http.StatusCreated, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var req dtoCommon.SecretRequest
+		if err := decodeJSON(r, &req); err != nil {
+			return nil, err
+		}
+		return dtoCommon.NewBaseResponse(req.RequestId, "", http.StatusCreated), nil
+	})
+
+	// the device service callbacks are only decoded and validated, the Server having no device service state
+	s.router.add(http.MethodPost, common.ApiDeviceCallbackRoute, http.StatusOK, callback(func() interface{} { return &requests.AddDeviceRequest{} }))
+	s.router.add(http.MethodPut, common.ApiDeviceCallbackRoute, http.StatusOK, callback(func() interface{} { return &requests.UpdateDeviceRequest{} }))
+	s.router.add(http.MethodDelete, common.ApiDeviceCallbackNameRoute, http.StatusOK, callback(nil))
+	s.router.add(http.MethodPut, common.ApiProfileCallbackRoute, http.StatusOK, callback(func() interface{} { return &requests.DeviceProfileRequest{} }))
+	s.router.add(http.MethodDelete, common.ApiProfileCallbackNameRoute, http.StatusOK, callback(nil))
+	s.router.add(http.MethodPost, common.ApiWatcherCallbackRoute, http.StatusOK, callback(func() interface{} { return &requests.AddProvisionWatcherRequest{} }))
+	s.router.add(http.MethodPut, common.ApiWatcherCallbackRoute, http.StatusOK, callback(func() interface{} { return &requests.UpdateProvisionWatcherRequest{} }))
+	s.router.add(http.MethodDelete, common.ApiWatcherCallbackNameRoute, http.StatusOK, callback(nil))
+	s.router.add(http.MethodPut, common.ApiServiceCallbackRoute, http.StatusOK, callback(func() interface{} { return &requests.UpdateDeviceServiceRequest{} }))
+}
+
+// callback decodes the request DTO created by newRequest, if any, and answers with a BaseResponse
+func callback(newRequest func() interface{}) handlerFunc {
+	return func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		if newRequest != nil {
+			if err := decodeJSON(r, newRequest()); err != nil {
+				return nil, err
+			}
+		}
+		return dtoCommon.NewBaseResponse("", "", http.StatusOK), nil
+	}
+}
+
+// addNotImplementedRoutes registers the remaining routes of common/constants.go: the routes by id, which have no
+// in-memory counterpart, and the routes of core-command, the device services and system management
+func (s *Server) addNotImplementedRoutes() {
+	routes := []struct {
+		method   string
+		template string
+	}{
+		{http.MethodGet, common.ApiEventIdRoute},
+		{http.MethodDelete, common.ApiEventIdRoute},
+		{http.MethodGet, common.ApiDeviceIdExistsRoute},
+		{http.MethodGet, common.ApiDeviceByIdRoute},
+		{http.MethodDelete, common.ApiDeviceByIdRoute},
+		{http.MethodGet, common.ApiDeviceByProfileIdRoute},
+		{http.MethodGet, common.ApiDeviceByServiceIdRoute},
+		{http.MethodGet, common.ApiDeviceProfileByIdRoute},
+		{http.MethodDelete, common.ApiDeviceProfileByIdRoute},
+		{http.MethodGet, common.ApiDeviceServiceByIdRoute},
+		{http.MethodDelete, common.ApiDeviceServiceByIdRoute},
+		{http.MethodGet, common.ApiProvisionWatcherByIdRoute},
+		{http.MethodDelete, common.ApiProvisionWatcherByIdRoute},
+		{http.MethodGet, common.ApiIntervalActionByTargetRoute},
+		{http.MethodGet, common.ApiDeviceNameCommandNameRoute},
+		{http.MethodPut, common.ApiDeviceNameCommandNameRoute},
+		{http.MethodPost, common.ApiDiscoveryRoute},
+		{http.MethodPost, common.ApiOperationRoute},
+		{http.MethodGet, common.ApiHealthRoute},
+		{http.MethodGet, common.ApiMultiMetricsRoute},
+		{http.MethodGet, common.ApiMultiConfigRoute},
+	}
+	for _, route := range routes {
+		s.router.add(route.method, route.template, http.StatusNotImplemented, notImplemented)
+	}
+}
+
+func notImplemented(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+	message := fmt.Sprintf("%s %s is not implemented by the reference server", r.Method, r.URL.Path)
+	return dtoCommon.NewBaseResponse("", message, http.StatusNotImplemented), nil
+}
@@ -0,0 +1,136 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package refserver
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+func (s *Server) addCoreDataRoutes() {
+	s.router.add(http.MethodPost, common.ApiEventProfileNameDeviceNameSourceNameRoute, http.StatusCreated, s.addEvent)
+	s.router.add(http.MethodGet, common.ApiAllEventRoute, http.StatusOK, paged(func(r *http.Request, _ map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Events.AllEvents(r.Context(), offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiEventCountRoute, http.StatusOK, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		return s.Events.EventCount(r.Context())
+	})
+	s.router.add(http.MethodGet, common.ApiEventCountByDeviceNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.Events.EventCountByDeviceName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodGet, common.ApiEventByDeviceNameRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Events.EventsByDeviceName(r.Context(), vars[common.Name], offset, limit)
+	}))
+	s.router.add(http.MethodDelete, common.ApiEventByDeviceNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.Events.DeleteByDeviceName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodGet, common.ApiEventByTimeRangeRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		start, end, err := timeRange(vars)
+		if err != nil {
+			return nil, err
+		}
+		return s.Events.EventsByTimeRange(r.Context(), start, end, offset, limit)
+	}))
+	s.router.add(http.MethodDelete, common.ApiEventByAgeRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		age, err := pathInt(vars, common.Age)
+		if err != nil {
+			return nil, err
+		}
+		return s.Events.DeleteByAge(r.Context(), age)
+	})
+
+	s.router.add(http.MethodGet, common.ApiAllReadingRoute, http.StatusOK, paged(func(r *http.Request, _ map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Readings.AllReadings(r.Context(), offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiReadingCountRoute, http.StatusOK, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		return s.Readings.ReadingCount(r.Context())
+	})
+	s.router.add(http.MethodGet, common.ApiReadingCountByDeviceNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.Readings.ReadingCountByDeviceName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodGet, common.ApiReadingByDeviceNameRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Readings.ReadingsByDeviceName(r.Context(), vars[common.Name], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiReadingByResourceNameRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Readings.ReadingsByResourceName(r.Context(), vars[common.ResourceName], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiReadingByTimeRangeRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		start, end, err := timeRange(vars)
+		if err != nil {
+			return nil, err
+		}
+		return s.Readings.ReadingsByTimeRange(r.Context(), start, end, offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiReadingByResourceNameAndTimeRangeRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		start, end, err := timeRange(vars)
+		if err != nil {
+			return nil, err
+		}
+		return s.Readings.ReadingsByResourceNameAndTimeRange(r.Context(), vars[common.ResourceName], start, end, offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiReadingByDeviceNameAndResourceNameRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Readings.ReadingsByDeviceNameAndResourceName(r.Context(), vars[common.Name], vars[common.ResourceName], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiReadingByDeviceNameAndResourceNameAndTimeRangeRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		start, end, err := timeRange(vars)
+		if err != nil {
+			return nil, err
+		}
+		return s.Readings.ReadingsByDeviceNameAndResourceNameAndTimeRange(r.Context(), vars[common.Name], vars[common.ResourceName], start, end, offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiReadingByDeviceNameAndTimeRangeRoute, http.StatusOK, paged(s.readingsByDeviceNameAndTimeRange))
+}
+
+// addEvent decodes the JSON or CBOR encoded event, whose profile, device and source names must match the path
+func (s *Server) addEvent(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindIOError, "failed to read the request body", err)
+	}
+	var req requests.AddEventRequest
+	if strings.HasPrefix(r.Header.Get(common.ContentType), common.ContentTypeCBOR) {
+		err = req.UnmarshalCBOR(body)
+	} else {
+		err = req.UnmarshalJSON(body)
+	}
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the event", err)
+	}
+
+	event := req.Event
+	if event.ProfileName != vars[common.ProfileName] || event.DeviceName != vars[common.DeviceName] ||
+		event.SourceName != vars[common.SourceName] {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf(
+			"the profile, device and source names of the event %s/%s/%s don't match the path %s/%s/%s",
+			event.ProfileName, event.DeviceName, event.SourceName,
+			vars[common.ProfileName], vars[common.DeviceName], vars[common.SourceName]), nil)
+	}
+	return s.Events.Add(r.Context(), req)
+}
+
+// readingsByDeviceNameAndTimeRange returns the readings of the device, restricted to the resource names of the optional
+// JSON request body, e.g. {"resourceNames": ["temperature", "humidity"]}
+func (s *Server) readingsByDeviceNameAndTimeRange(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+	start, end, err := timeRange(vars)
+	if err != nil {
+		return nil, err
+	}
+	var query struct {
+		ResourceNames []string `json:"resourceNames"`
+	}
+	if r.ContentLength != 0 {
+		if err = decodeJSON(r, &query); err != nil {
+			return nil, err
+		}
+	}
+	return s.Readings.ReadingsByDeviceNameAndResourceNamesAndTimeRange(r.Context(), vars[common.Name], query.ResourceNames, start, end, offset, limit)
+}
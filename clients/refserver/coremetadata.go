@@ -0,0 +1,197 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package refserver
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"gopkg.in/yaml.v3"
+)
+
+// uploadFileField is the multipart form field of the device profile YAML file
+const uploadFileField = "file"
+
+func (s *Server) addCoreMetadataRoutes() {
+	s.router.add(http.MethodPost, common.ApiDeviceRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.AddDeviceRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.Devices.Add(r.Context(), reqs)
+	})
+	s.router.add(http.MethodPatch, common.ApiDeviceRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.UpdateDeviceRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.Devices.Update(r.Context(), reqs)
+	})
+	s.router.add(http.MethodGet, common.ApiAllDeviceRoute, http.StatusOK, paged(func(r *http.Request, _ map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Devices.AllDevices(r.Context(), labels(r), offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiDeviceNameExistsRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.Devices.DeviceNameExists(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodGet, common.ApiDeviceByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.Devices.DeviceByName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodDelete, common.ApiDeviceByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.Devices.DeleteDeviceByName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodGet, common.ApiDeviceByProfileNameRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Devices.DevicesByProfileName(r.Context(), vars[common.Name], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiDeviceByServiceNameRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Devices.DevicesByServiceName(r.Context(), vars[common.Name], offset, limit)
+	}))
+
+	s.router.add(http.MethodPost, common.ApiDeviceProfileRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.DeviceProfileRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.DeviceProfiles.Add(r.Context(), reqs)
+	})
+	s.router.add(http.MethodPut, common.ApiDeviceProfileRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.DeviceProfileRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.DeviceProfiles.Update(r.Context(), reqs)
+	})
+	s.router.add(http.MethodPost, common.ApiDeviceProfileUploadFileRoute, http.StatusCreated, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		req, err := uploadedProfile(r)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.DeviceProfiles.Add(r.Context(), []requests.DeviceProfileRequest{req})
+		if err != nil {
+			return nil, err
+		}
+		return res[0], itemError(res[0].BaseResponse)
+	})
+	s.router.add(http.MethodPut, common.ApiDeviceProfileUploadFileRoute, http.StatusOK, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		req, err := uploadedProfile(r)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.DeviceProfiles.Update(r.Context(), []requests.DeviceProfileRequest{req})
+		if err != nil {
+			return nil, err
+		}
+		return res[0], itemError(res[0])
+	})
+	s.router.add(http.MethodGet, common.ApiDeviceProfileByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.DeviceProfiles.DeviceProfileByName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodDelete, common.ApiDeviceProfileByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.DeviceProfiles.DeleteByName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodGet, common.ApiAllDeviceProfileRoute, http.StatusOK, paged(func(r *http.Request, _ map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.DeviceProfiles.AllDeviceProfiles(r.Context(), labels(r), offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiDeviceProfileByModelRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.DeviceProfiles.DeviceProfilesByModel(r.Context(), vars[common.Model], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiDeviceProfileByManufacturerRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.DeviceProfiles.DeviceProfilesByManufacturer(r.Context(), vars[common.Manufacturer], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiDeviceProfileByManufacturerAndModelRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.DeviceProfiles.DeviceProfilesByManufacturerAndModel(r.Context(), vars[common.Manufacturer], vars[common.Model], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiDeviceResourceByProfileAndResourceRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.DeviceProfiles.DeviceResourceByProfileNameAndResourceName(r.Context(), vars[common.ProfileName], vars[common.ResourceName])
+	})
+
+	s.router.add(http.MethodPost, common.ApiDeviceServiceRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.AddDeviceServiceRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.DeviceServices.Add(r.Context(), reqs)
+	})
+	s.router.add(http.MethodPatch, common.ApiDeviceServiceRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.UpdateDeviceServiceRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.DeviceServices.Update(r.Context(), reqs)
+	})
+	s.router.add(http.MethodGet, common.ApiAllDeviceServiceRoute, http.StatusOK, paged(func(r *http.Request, _ map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.DeviceServices.AllDeviceServices(r.Context(), labels(r), offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiDeviceServiceByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.DeviceServices.DeviceServiceByName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodDelete, common.ApiDeviceServiceByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.DeviceServices.DeleteByName(r.Context(), vars[common.Name])
+	})
+
+	s.router.add(http.MethodPost, common.ApiProvisionWatcherRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.AddProvisionWatcherRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.ProvisionWatchers.Add(r.Context(), reqs)
+	})
+	s.router.add(http.MethodPatch, common.ApiProvisionWatcherRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.UpdateProvisionWatcherRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.ProvisionWatchers.Update(r.Context(), reqs)
+	})
+	s.router.add(http.MethodGet, common.ApiAllProvisionWatcherRoute, http.StatusOK, paged(func(r *http.Request, _ map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.ProvisionWatchers.AllProvisionWatchers(r.Context(), labels(r), offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiProvisionWatcherByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.ProvisionWatchers.ProvisionWatcherByName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodDelete, common.ApiProvisionWatcherByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.ProvisionWatchers.DeleteProvisionWatcherByName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodGet, common.ApiProvisionWatcherByProfileNameRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.ProvisionWatchers.ProvisionWatchersByProfileName(r.Context(), vars[common.Name], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiProvisionWatcherByServiceNameRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.ProvisionWatchers.ProvisionWatchersByServiceName(r.Context(), vars[common.Name], offset, limit)
+	}))
+}
+
+// uploadedProfile decodes the device profile YAML file of the multipart form
+func uploadedProfile(r *http.Request) (requests.DeviceProfileRequest, errors.EdgeX) {
+	file, _, err := r.FormFile(uploadFileField)
+	if err != nil {
+		return requests.DeviceProfileRequest{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("missing the %s form field", uploadFileField), err)
+	}
+	defer file.Close()
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return requests.DeviceProfileRequest{}, errors.NewCommonEdgeX(errors.KindIOError, "failed to read the device profile YAML file", err)
+	}
+	var profile dtos.DeviceProfile
+	if err = yaml.Unmarshal(data, &profile); err != nil {
+		return requests.DeviceProfileRequest{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the device profile YAML file", err)
+	}
+	return requests.NewDeviceProfileRequest(profile), nil
+}
+
+// itemError returns the error of a failed item response of a batch, so that a single-item route answers with the
+// status code of the item
+func itemError(res dtoCommon.BaseResponse) errors.EdgeX {
+	if res.StatusCode < http.StatusBadRequest {
+		return nil
+	}
+	return errors.NewCommonEdgeX(errors.KindMapping(res.StatusCode), res.Message, nil)
+}
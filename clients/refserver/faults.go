@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package refserver
+
+import (
+	"net/http"
+	"time"
+)
+
+// Fault alters the handling of the requests it matches, so that the error paths of the clients can be tested
+type Fault struct {
+	// Method is the HTTP method of the matched requests. Empty matches any method.
+	Method string
+	// Route is the route template of the matched requests, e.g. common.ApiDeviceByNameRoute. Empty matches any route,
+	// including the paths without route.
+	Route string
+	// Latency delays the response, or until the request is canceled by the client
+	Latency time.Duration
+	// StatusCode, when set, answers the requests with this status code and an error BaseResponse instead of handling
+	// them
+	StatusCode int
+	// TruncateBody sends only the first half of the response body, while the Content-Length header announces the
+	// whole body, so that the client fails to read the response
+	TruncateBody bool
+	// Times is the number of requests the fault applies to, after which it's removed. Zero applies the fault until
+	// the faults are cleared.
+	Times int
+}
+
+// InjectFault adds a fault applied to the matching requests. When several faults match a request, the first one
+// injected applies.
+func (s *Server) InjectFault(fault Fault) {
+	s.faultMutex.Lock()
+	defer s.faultMutex.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all the faults
+func (s *Server) ClearFaults() {
+	s.faultMutex.Lock()
+	defer s.faultMutex.Unlock()
+	s.faults = nil
+}
+
+// fault returns the fault applying to the request matched with the route template, if any, and counts it as applied
+func (s *Server) fault(r *http.Request, template string) (Fault, bool) {
+	s.faultMutex.Lock()
+	defer s.faultMutex.Unlock()
+	for i, fault := range s.faults {
+		if (fault.Method != "" && fault.Method != r.Method) || (fault.Route != "" && fault.Route != template) {
+			continue
+		}
+		applied := *fault
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return applied, true
+	}
+	return Fault{}, false
+}
+
+// delay waits for the latency of the fault, it returns false if the request is canceled meanwhile
+func delay(r *http.Request, latency time.Duration) bool {
+	if latency <= 0 {
+		return true
+	}
+	timer := time.NewTimer(latency)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-r.Context().Done():
+		return false
+	}
+}
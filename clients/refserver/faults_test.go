@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package refserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	clientsHttp "github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjectFault(t *testing.T) {
+	server := NewServer(Store{})
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	client := clientsHttp.NewCommonClient(ts.URL)
+
+	server.InjectFault(Fault{Method: http.MethodGet, Route: common.ApiPingRoute, StatusCode: http.StatusServiceUnavailable, Times: 2})
+	for i := 0; i < 2; i++ {
+		_, err := client.Ping(context.Background())
+		require.Error(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, err.Code())
+	}
+	_, err := client.Ping(context.Background())
+	require.NoError(t, err, "the fault is removed once applied twice")
+
+	server.InjectFault(Fault{Route: common.ApiVersionRoute, StatusCode: http.StatusInternalServerError})
+	_, err = client.Ping(context.Background())
+	require.NoError(t, err, "the fault only applies to its route")
+	_, err = client.Version(context.Background())
+	require.Error(t, err)
+	server.ClearFaults()
+	_, err = client.Version(context.Background())
+	require.NoError(t, err)
+}
+
+func TestInjectFaultLatency(t *testing.T) {
+	server := NewServer(Store{})
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	client := clientsHttp.NewCommonClient(ts.URL)
+
+	server.InjectFault(Fault{Latency: 50 * time.Millisecond})
+	start := time.Now()
+	_, err := client.Ping(context.Background())
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(50*time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.Ping(ctx)
+	require.Error(t, err)
+}
+
+func TestInjectFaultTruncateBody(t *testing.T) {
+	server := NewServer(Store{})
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	client := clientsHttp.NewCommonClient(ts.URL)
+
+	server.InjectFault(Fault{TruncateBody: true, Times: 1})
+	_, err := client.Ping(context.Background())
+	require.Error(t, err)
+	_, err = client.Ping(context.Background())
+	require.NoError(t, err)
+}
@@ -0,0 +1,129 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package refserver
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+func (s *Server) addNotificationsRoutes() {
+	s.router.add(http.MethodPost, common.ApiSubscriptionRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.AddSubscriptionRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.Subscriptions.Add(r.Context(), reqs)
+	})
+	s.router.add(http.MethodPatch, common.ApiSubscriptionRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.UpdateSubscriptionRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.Subscriptions.Update(r.Context(), reqs)
+	})
+	s.router.add(http.MethodGet, common.ApiAllSubscriptionRoute, http.StatusOK, paged(func(r *http.Request, _ map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Subscriptions.AllSubscriptions(r.Context(), offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiSubscriptionByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.Subscriptions.SubscriptionByName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodDelete, common.ApiSubscriptionByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.Subscriptions.DeleteSubscriptionByName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodGet, common.ApiSubscriptionByCategoryRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Subscriptions.SubscriptionsByCategory(r.Context(), vars[common.Category], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiSubscriptionByLabelRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Subscriptions.SubscriptionsByLabel(r.Context(), vars[common.Label], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiSubscriptionByReceiverRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Subscriptions.SubscriptionsByReceiver(r.Context(), vars[common.Receiver], offset, limit)
+	}))
+
+	s.router.add(http.MethodPost, common.ApiNotificationRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.AddNotificationRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.Notifications.SendNotification(r.Context(), reqs)
+	})
+	s.router.add(http.MethodGet, common.ApiNotificationByIdRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.Notifications.NotificationById(r.Context(), vars[common.Id])
+	})
+	s.router.add(http.MethodDelete, common.ApiNotificationByIdRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.Notifications.DeleteNotificationById(r.Context(), vars[common.Id])
+	})
+	s.router.add(http.MethodGet, common.ApiNotificationByCategoryRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Notifications.NotificationsByCategory(r.Context(), vars[common.Category], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiNotificationByLabelRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Notifications.NotificationsByLabel(r.Context(), vars[common.Label], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiNotificationByStatusRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Notifications.NotificationsByStatus(r.Context(), vars[common.Status], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiNotificationByTimeRangeRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		start, end, err := timeRange(vars)
+		if err != nil {
+			return nil, err
+		}
+		return s.Notifications.NotificationsByTimeRange(r.Context(), start, end, offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiNotificationBySubscriptionNameRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Notifications.NotificationsBySubscriptionName(r.Context(), vars[common.Name], offset, limit)
+	}))
+	s.router.add(http.MethodDelete, common.ApiNotificationByAgeRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		age, err := pathInt(vars, common.Age)
+		if err != nil {
+			return nil, err
+		}
+		return s.Notifications.DeleteProcessedNotificationsByAge(r.Context(), age)
+	})
+	s.router.add(http.MethodDelete, common.ApiNotificationCleanupRoute, http.StatusOK, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		return s.Notifications.CleanupNotifications(r.Context())
+	})
+	s.router.add(http.MethodDelete, common.ApiNotificationCleanupByAgeRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		age, err := pathInt(vars, common.Age)
+		if err != nil {
+			return nil, err
+		}
+		return s.Notifications.CleanupNotificationsByAge(r.Context(), age)
+	})
+
+	s.router.add(http.MethodGet, common.ApiTransmissionByIdRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.Transmissions.TransmissionById(r.Context(), vars[common.Id])
+	})
+	s.router.add(http.MethodGet, common.ApiAllTransmissionRoute, http.StatusOK, paged(func(r *http.Request, _ map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Transmissions.AllTransmissions(r.Context(), offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiTransmissionByTimeRangeRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		start, end, err := timeRange(vars)
+		if err != nil {
+			return nil, err
+		}
+		return s.Transmissions.TransmissionsByTimeRange(r.Context(), start, end, offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiTransmissionByStatusRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Transmissions.TransmissionsByStatus(r.Context(), vars[common.Status], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiTransmissionBySubscriptionNameRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Transmissions.TransmissionsBySubscriptionName(r.Context(), vars[common.Name], offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiTransmissionByNotificationIdRoute, http.StatusOK, paged(func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Transmissions.TransmissionsByNotificationId(r.Context(), vars[common.Id], offset, limit)
+	}))
+	s.router.add(http.MethodDelete, common.ApiTransmissionByAgeRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		age, err := pathInt(vars, common.Age)
+		if err != nil {
+			return nil, err
+		}
+		return s.Transmissions.DeleteProcessedTransmissionsByAge(r.Context(), age)
+	})
+}
@@ -0,0 +1,101 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package refserver
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// handlerFunc handles a request whose path matched a route, the vars being the unescaped values of the path variables.
+// The response is written with the success status of the route, or the error with its status code.
+type handlerFunc func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX)
+
+// route is a route template of common/constants.go, e.g. common.ApiDeviceByNameRoute, handled for one HTTP method
+type route struct {
+	method   string
+	template string
+	segments []string
+	status   int
+	handle   handlerFunc
+}
+
+// router matches the request paths against the route templates
+type router struct {
+	routes []*route
+}
+
+func (rt *router) add(method string, template string, status int, handle handlerFunc) {
+	rt.routes = append(rt.routes, &route{
+		method:   method,
+		template: template,
+		segments: splitPath(template),
+		status:   status,
+		handle:   handle,
+	})
+}
+
+// match returns the route of the request and the values of its path variables. When several templates match the path,
+// the one with the most literal segments wins, e.g. /event/age/{age} over /event/{profileName}/{deviceName}. The
+// returned status is http.StatusNotFound or http.StatusMethodNotAllowed when no route matches.
+func (rt *router) match(r *http.Request) (*route, map[string]string, int) {
+	segments := splitPath(r.URL.EscapedPath())
+	var matched *route
+	var matchedVars map[string]string
+	literals := -1
+	pathMatched := false
+	for _, candidate := range rt.routes {
+		vars, count, ok := candidate.matchSegments(segments)
+		if !ok {
+			continue
+		}
+		pathMatched = true
+		if candidate.method != r.Method || count <= literals {
+			continue
+		}
+		matched, matchedVars, literals = candidate, vars, count
+	}
+	switch {
+	case matched != nil:
+		return matched, matchedVars, http.StatusOK
+	case pathMatched:
+		return nil, nil, http.StatusMethodNotAllowed
+	default:
+		return nil, nil, http.StatusNotFound
+	}
+}
+
+// matchSegments matches the escaped segments of a path and returns the unescaped path variables, as well as the
+// number of literal segments of the template. The clients escape the path variables with url.QueryEscape.
+func (rt *route) matchSegments(segments []string) (map[string]string, int, bool) {
+	if len(segments) != len(rt.segments) {
+		return nil, 0, false
+	}
+	vars := make(map[string]string)
+	literals := 0
+	for i, segment := range rt.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			value, err := url.QueryUnescape(segments[i])
+			if err != nil {
+				return nil, 0, false
+			}
+			vars[segment[1:len(segment)-1]] = value
+			continue
+		}
+		if segment != segments[i] {
+			return nil, 0, false
+		}
+		literals++
+	}
+	return vars, literals, true
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
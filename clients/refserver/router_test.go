@@ -0,0 +1,56 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package refserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouterMatch(t *testing.T) {
+	var rt router
+	rt.add(http.MethodPost, common.ApiEventProfileNameDeviceNameSourceNameRoute, http.StatusCreated, nil)
+	rt.add(http.MethodDelete, common.ApiEventByAgeRoute, http.StatusOK, nil)
+	rt.add(http.MethodDelete, common.ApiEventByDeviceNameRoute, http.StatusOK, nil)
+	rt.add(http.MethodGet, common.ApiDeviceByNameRoute, http.StatusOK, nil)
+
+	tests := []struct {
+		name             string
+		method           string
+		path             string
+		expectedTemplate string
+		expectedVars     map[string]string
+		expectedStatus   int
+	}{
+		{"literal segments win", http.MethodDelete, common.ApiEventRoute + "/age/10", common.ApiEventByAgeRoute, map[string]string{common.Age: "10"}, http.StatusOK},
+		{"path variables", http.MethodPost, common.ApiEventRoute + "/p/d/s", common.ApiEventProfileNameDeviceNameSourceNameRoute,
+			map[string]string{common.ProfileName: "p", common.DeviceName: "d", common.SourceName: "s"}, http.StatusOK},
+		{"query escaped variable", http.MethodGet, common.ApiDeviceRoute + "/name/" + url.QueryEscape("a b/c+d"), common.ApiDeviceByNameRoute,
+			map[string]string{common.Name: "a b/c+d"}, http.StatusOK},
+		{"method not allowed", http.MethodGet, common.ApiEventRoute + "/age/10", "", nil, http.StatusMethodNotAllowed},
+		{"not found", http.MethodGet, common.ApiDeviceRoute + "/unknown", "", nil, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			matched, vars, status := rt.match(httptest.NewRequest(testCase.method, testCase.path, nil))
+			assert.Equal(t, testCase.expectedStatus, status)
+			if testCase.expectedTemplate == "" {
+				assert.Nil(t, matched)
+				return
+			}
+			require.NotNil(t, matched)
+			assert.Equal(t, testCase.expectedTemplate, matched.template)
+			assert.Equal(t, testCase.expectedVars, vars)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package refserver
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+func (s *Server) addSchedulerRoutes() {
+	s.router.add(http.MethodPost, common.ApiIntervalRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.AddIntervalRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.Intervals.Add(r.Context(), reqs)
+	})
+	s.router.add(http.MethodPatch, common.ApiIntervalRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.UpdateIntervalRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.Intervals.Update(r.Context(), reqs)
+	})
+	s.router.add(http.MethodGet, common.ApiAllIntervalRoute, http.StatusOK, paged(func(r *http.Request, _ map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.Intervals.AllIntervals(r.Context(), offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiIntervalByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.Intervals.IntervalByName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodDelete, common.ApiIntervalByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.Intervals.DeleteIntervalByName(r.Context(), vars[common.Name])
+	})
+
+	s.router.add(http.MethodPost, common.ApiIntervalActionRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.AddIntervalActionRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.IntervalActions.Add(r.Context(), reqs)
+	})
+	s.router.add(http.MethodPatch, common.ApiIntervalActionRoute, http.StatusMultiStatus, func(r *http.Request, _ map[string]string) (interface{}, errors.EdgeX) {
+		var reqs []requests.UpdateIntervalActionRequest
+		if err := decodeJSON(r, &reqs); err != nil {
+			return nil, err
+		}
+		return s.IntervalActions.Update(r.Context(), reqs)
+	})
+	s.router.add(http.MethodGet, common.ApiAllIntervalActionRoute, http.StatusOK, paged(func(r *http.Request, _ map[string]string, offset int, limit int) (interface{}, errors.EdgeX) {
+		return s.IntervalActions.AllIntervalActions(r.Context(), offset, limit)
+	}))
+	s.router.add(http.MethodGet, common.ApiIntervalActionByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.IntervalActions.IntervalActionByName(r.Context(), vars[common.Name])
+	})
+	s.router.add(http.MethodDelete, common.ApiIntervalActionByNameRoute, http.StatusOK, func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		return s.IntervalActions.DeleteIntervalActionByName(r.Context(), vars[common.Name])
+	})
+}
@@ -0,0 +1,225 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package refserver provides a reference implementation of the v2 REST API of the core services, so that the clients
+// can be tested without running the services, e.g. with httptest.NewServer(refserver.NewServer(refserver.Store{})).
+//
+// The Server serves the routes of core-data, core-metadata, support-notifications and support-scheduler, as well as
+// the common routes and the device service callbacks, from the in-memory clients of the fakes package. It decodes and
+// validates the request DTOs, and answers with the response DTOs and the status codes of the services. The routes
+// without in-memory counterpart, e.g. the ones by id, and the command, discovery and system management routes answer
+// with 501 Not Implemented. The core-command routes sharing their path with core-metadata, e.g. /device/all, are served
+// as core-metadata.
+//
+// Faults can be injected to test the error paths of the clients: latency, error status codes and truncated bodies.
+package refserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/fakes"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// Store holds the in-memory clients backing the routes of the Server. The nil clients are created empty when the
+// Server is created, the ReadingClient reading the events of the EventClient and the NotificationClient using the
+// SubscriptionClient and the TransmissionClient.
+type Store struct {
+	Devices           *fakes.DeviceClient
+	DeviceProfiles    *fakes.DeviceProfileClient
+	DeviceServices    *fakes.DeviceServiceClient
+	ProvisionWatchers *fakes.ProvisionWatcherClient
+	Events            *fakes.EventClient
+	Readings          *fakes.ReadingClient
+	Subscriptions     *fakes.SubscriptionClient
+	Notifications     *fakes.NotificationClient
+	Transmissions     *fakes.TransmissionClient
+	Intervals         *fakes.IntervalClient
+	IntervalActions   *fakes.IntervalActionClient
+}
+
+// Server is a http.Handler serving the v2 routes from the in-memory clients of its Store
+type Server struct {
+	Store
+	router     router
+	faults     []*Fault
+	faultMutex sync.Mutex
+}
+
+// NewServer creates a Server backed by the in-memory clients of the store
+func NewServer(store Store) *Server {
+	if store.Devices == nil {
+		store.Devices = fakes.NewDeviceClient()
+	}
+	if store.DeviceProfiles == nil {
+		store.DeviceProfiles = fakes.NewDeviceProfileClient()
+	}
+	if store.DeviceServices == nil {
+		store.DeviceServices = fakes.NewDeviceServiceClient()
+	}
+	if store.ProvisionWatchers == nil {
+		store.ProvisionWatchers = fakes.NewProvisionWatcherClient()
+	}
+	if store.Events == nil {
+		store.Events = fakes.NewEventClient()
+	}
+	if store.Readings == nil {
+		store.Readings = fakes.NewReadingClient(store.Events)
+	}
+	if store.Subscriptions == nil {
+		store.Subscriptions = fakes.NewSubscriptionClient()
+	}
+	if store.Transmissions == nil {
+		store.Transmissions = fakes.NewTransmissionClient()
+	}
+	if store.Notifications == nil {
+		store.Notifications = fakes.NewNotificationClient(store.Subscriptions, store.Transmissions)
+	}
+	if store.Intervals == nil {
+		store.Intervals = fakes.NewIntervalClient()
+	}
+	if store.IntervalActions == nil {
+		store.IntervalActions = fakes.NewIntervalActionClient()
+	}
+
+	s := &Server{Store: store}
+	s.addCommonRoutes()
+	s.addCoreDataRoutes()
+	s.addCoreMetadataRoutes()
+	s.addNotificationsRoutes()
+	s.addSchedulerRoutes()
+	s.addNotImplementedRoutes()
+	return s
+}
+
+// ServeHTTP handles the request with the route matching its method and path, applying the injected faults
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	matched, vars, status := s.router.match(r)
+	template := ""
+	if matched != nil {
+		template = matched.template
+	}
+	fault, faulty := s.fault(r, template)
+	if faulty && !delay(r, fault.Latency) {
+		return
+	}
+
+	var res interface{}
+	switch {
+	case faulty && fault.StatusCode != 0:
+		status = fault.StatusCode
+		res = dtoCommon.NewBaseResponse("", "injected fault", status)
+	case matched == nil:
+		res = dtoCommon.NewBaseResponse("", fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path), status)
+	default:
+		var err errors.EdgeX
+		res, err = matched.handle(r, vars)
+		status = matched.status
+		if err != nil {
+			status = err.Code()
+			res = dtoCommon.NewBaseResponse("", err.Error(), status)
+		}
+	}
+	write(w, status, res, faulty && fault.TruncateBody)
+}
+
+// write writes the JSON encoded response, only the first half of the body if truncated
+func write(w http.ResponseWriter, status int, res interface{}, truncated bool) {
+	body, err := json.Marshal(res)
+	if err != nil {
+		status = http.StatusInternalServerError
+		body, _ = json.Marshal(dtoCommon.NewBaseResponse("", err.Error(), status))
+	}
+	w.Header().Set(common.ContentType, common.ContentTypeJSON)
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(status)
+	if truncated {
+		body = body[:len(body)/2]
+	}
+	_, _ = w.Write(body)
+}
+
+// decodeJSON decodes the JSON request body into v, the request DTOs being validated as they are decoded
+func decodeJSON(r *http.Request, v interface{}) errors.EdgeX {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindIOError, "failed to read the request body", err)
+	}
+	if err = json.Unmarshal(body, v); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the JSON request body", err)
+	}
+	return nil
+}
+
+// pagedFunc handles a request of a route taking the offset and limit query parameters
+type pagedFunc func(r *http.Request, vars map[string]string, offset int, limit int) (interface{}, errors.EdgeX)
+
+// paged parses the offset and limit query parameters, defaulting to common.DefaultOffset and common.DefaultLimit
+func paged(handle pagedFunc) handlerFunc {
+	return func(r *http.Request, vars map[string]string) (interface{}, errors.EdgeX) {
+		offset, err := queryInt(r, common.Offset, common.DefaultOffset)
+		if err != nil {
+			return nil, err
+		}
+		limit, err := queryInt(r, common.Limit, common.DefaultLimit)
+		if err != nil {
+			return nil, err
+		}
+		return handle(r, vars, offset, limit)
+	}
+}
+
+func queryInt(r *http.Request, key string, defaultValue int) (int, errors.EdgeX) {
+	value := r.URL.Query().Get(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the %s query parameter %s", key, value), err)
+	}
+	return i, nil
+}
+
+func pathInt(vars map[string]string, key string) (int, errors.EdgeX) {
+	i, err := strconv.Atoi(vars[key])
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the %s path parameter %s", key, vars[key]), err)
+	}
+	return i, nil
+}
+
+// timeRange parses the start and end path parameters
+func timeRange(vars map[string]string) (int, int, errors.EdgeX) {
+	start, err := pathInt(vars, common.Start)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := pathInt(vars, common.End)
+	if err != nil {
+		return 0, 0, err
+	}
+	if end < start {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "end must be greater than or equal to start", nil)
+	}
+	return start, end, nil
+}
+
+// labels parses the comma separated labels query parameter
+func labels(r *http.Request) []string {
+	value := r.URL.Query().Get(common.Labels)
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, common.CommaSeparator)
+}
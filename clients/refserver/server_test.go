@@ -0,0 +1,239 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package refserver
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/fakes"
+	clientsHttp "github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDevice(name string, profileName string, labels ...string) dtos.Device {
+	return dtos.Device{
+		Name:           name,
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		Labels:         labels,
+		ServiceName:    "testService",
+		ProfileName:    profileName,
+		Protocols:      map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": "localhost"}},
+	}
+}
+
+func TestServerDeviceRoutes(t *testing.T) {
+	server := NewServer(Store{Devices: fakes.NewDeviceClient(testDevice("existing", "profile1", "label1"))})
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	client := clientsHttp.NewDeviceClient(ts.URL)
+
+	res, err := client.Add(context.Background(), []requests.AddDeviceRequest{
+		requests.NewAddDeviceRequest(testDevice("device1", "profile1", "label1", "label2")),
+		requests.NewAddDeviceRequest(testDevice("existing", "profile2")),
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, http.StatusCreated, res[0].StatusCode)
+	assert.NotEmpty(t, res[0].Id)
+	assert.Equal(t, http.StatusConflict, res[1].StatusCode)
+
+	device, err := client.DeviceByName(context.Background(), "device1")
+	require.NoError(t, err)
+	assert.Equal(t, res[0].Id, device.Device.Id)
+	_, err = client.DeviceByName(context.Background(), "missing")
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	labelled, err := client.AllDevices(context.Background(), []string{"label2"}, 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), labelled.TotalCount)
+	paged, err := client.DevicesByProfileName(context.Background(), "profile1", 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), paged.TotalCount)
+	assert.Len(t, paged.Devices, 1)
+	_, err = client.AllDevices(context.Background(), nil, 10, 1)
+	assert.Equal(t, errors.KindRangeNotSatisfiable, errors.Kind(err))
+
+	_, err = client.Add(context.Background(), []requests.AddDeviceRequest{requests.NewAddDeviceRequest(dtos.Device{Name: "invalid"})})
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	_, err = client.DeleteDeviceByName(context.Background(), "device1")
+	require.NoError(t, err)
+	_, err = server.Devices.DeviceByName(context.Background(), "device1")
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestServerDeviceProfileUpload(t *testing.T) {
+	ts := httptest.NewServer(NewServer(Store{}))
+	defer ts.Close()
+	client := clientsHttp.NewDeviceProfileClient(ts.URL)
+
+	profileFile := filepath.Join(t.TempDir(), "profile.yaml")
+	require.NoError(t, ioutil.WriteFile(profileFile, []byte(`
+name: "uploaded"
+manufacturer: "IOTech"
+model: "B"
+deviceResources:
+  - name: "temperature"
+    properties:
+      valueType: "Int32"
+      readWrite: "R"
+deviceCommands:
+  - name: "readings"
+    readWrite: "R"
+    resourceOperations:
+      - deviceResource: "temperature"
+`), 0600))
+
+	res, err := client.AddByYaml(context.Background(), profileFile)
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.Id)
+	_, err = client.AddByYaml(context.Background(), profileFile)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusConflict, err.Code())
+
+	resource, err := client.DeviceResourceByProfileNameAndResourceName(context.Background(), "uploaded", "temperature")
+	require.NoError(t, err)
+	assert.Equal(t, common.ValueTypeInt32, resource.Resource.Properties.ValueType)
+	profiles, err := client.DeviceProfilesByManufacturerAndModel(context.Background(), "IOTech", "B", 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), profiles.TotalCount)
+}
+
+func TestServerEventRoutes(t *testing.T) {
+	ts := httptest.NewServer(NewServer(Store{}))
+	defer ts.Close()
+	events := clientsHttp.NewEventClient(ts.URL)
+	readings := clientsHttp.NewReadingClient(ts.URL)
+
+	event := dtos.NewEvent("profile1", "device1", "source1")
+	event.Origin = 100
+	require.NoError(t, event.AddSimpleReading("temperature", common.ValueTypeInt32, int32(20)))
+	require.NoError(t, event.AddSimpleReading("humidity", common.ValueTypeInt32, int32(50)))
+	res, err := events.Add(context.Background(), requests.NewAddEventRequest(event))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+	binary := dtos.NewEvent("profile1", "device1", "source2")
+	binary.AddBinaryReading("image", []byte{0xff, 0xd8}, "image/jpeg")
+	_, err = events.Add(context.Background(), requests.NewAddEventRequest(binary))
+	require.NoError(t, err, "the binary readings are sent in CBOR")
+
+	count, err := events.EventCountByDeviceName(context.Background(), "device1")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), count.Count)
+	image, err := readings.ReadingsByResourceName(context.Background(), "image", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, image.Readings, 1)
+	assert.Equal(t, []byte{0xff, 0xd8}, image.Readings[0].BinaryValue)
+
+	now := int(time.Now().UnixNano())
+	temperatures, err := readings.ReadingsByDeviceNameAndResourceNamesAndTimeRange(context.Background(), "device1", []string{"temperature"}, 0, now, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, temperatures.Readings, 1)
+	assert.Equal(t, "temperature", temperatures.Readings[0].ResourceName)
+	all, err := readings.ReadingsByDeviceNameAndResourceNamesAndTimeRange(context.Background(), "device1", nil, 0, now, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), all.TotalCount)
+	_, err = readings.ReadingsByTimeRange(context.Background(), 1000, 0, 0, 10)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	_, err = events.DeleteByDeviceName(context.Background(), "device1")
+	require.NoError(t, err)
+	readingCount, err := readings.ReadingCount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), readingCount.Count)
+}
+
+func TestServerEventPathMismatch(t *testing.T) {
+	ts := httptest.NewServer(NewServer(Store{}))
+	defer ts.Close()
+
+	body := `{"apiVersion":"v2","event":{"apiVersion":"v2","id":"` + dtos.NewEvent("", "", "").Id + `","profileName":"profile1",` +
+		`"deviceName":"device1","sourceName":"source1","origin":1,"readings":[]}}`
+	resp, err := http.Post(ts.URL+common.ApiEventRoute+"/profile1/device2/source1", common.ContentTypeJSON, strings.NewReader(body))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestServerNotificationRoutes(t *testing.T) {
+	ts := httptest.NewServer(NewServer(Store{}))
+	defer ts.Close()
+	subscriptions := clientsHttp.NewSubscriptionClient(ts.URL)
+	notifications := clientsHttp.NewNotificationClient(ts.URL)
+
+	_, err := subscriptions.Add(context.Background(), []requests.AddSubscriptionRequest{requests.NewAddSubscriptionRequest(dtos.Subscription{
+		Name:       "alerts",
+		Receiver:   "operator",
+		Categories: []string{"health"},
+		Channels:   []dtos.Address{dtos.NewEmailAddress([]string{"ops@example.com"})},
+		AdminState: models.Unlocked,
+	})})
+	require.NoError(t, err)
+	res, err := notifications.SendNotification(context.Background(), []requests.AddNotificationRequest{
+		requests.NewAddNotificationRequest(dtos.NewNotification(nil, "health", "disk full", "core-data", models.Critical)),
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+
+	subscribed, err := notifications.NotificationsBySubscriptionName(context.Background(), "alerts", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, subscribed.Notifications, 1)
+	assert.Equal(t, res[0].Id, subscribed.Notifications[0].Id)
+
+	_, err = notifications.CleanupNotifications(context.Background())
+	require.NoError(t, err)
+	_, err = notifications.NotificationById(context.Background(), res[0].Id)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestServerCommonRoutes(t *testing.T) {
+	ts := httptest.NewServer(NewServer(Store{}))
+	defer ts.Close()
+	client := clientsHttp.NewCommonClient(ts.URL)
+
+	_, err := client.Ping(context.Background())
+	require.NoError(t, err)
+	version, err := client.Version(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, serviceVersion, version.Version)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		expectedStatus int
+	}{
+		{"not found", http.MethodGet, common.ApiBase + "/unknown", http.StatusNotFound},
+		{"method not allowed", http.MethodPut, common.ApiPingRoute, http.StatusMethodNotAllowed},
+		{"not implemented", http.MethodGet, common.ApiHealthRoute, http.StatusNotImplemented},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(testCase.method, ts.URL+testCase.path, nil)
+			require.NoError(t, err)
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+			assert.Equal(t, testCase.expectedStatus, resp.StatusCode)
+		})
+	}
+}
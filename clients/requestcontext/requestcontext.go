@@ -0,0 +1,201 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package requestcontext carries the metadata of a request, e.g. the correlation ID and the tenant, in the context with
+// unexported typed keys, so that the values can't collide with the ones of other packages. The inbound server code
+// extracts the metadata from the request headers with Extract, and the service clients of every transport forward it
+// to the next service with Propagate, along with the Correlation ID and the W3C Trace Context.
+package requestcontext
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/tracecontext"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+
+	"github.com/google/uuid"
+)
+
+type contextKey int
+
+// reservedHeaders are the headers which are never forwarded, since they describe the outbound request itself or its
+// connection, or carry the credentials of the service making it
+var reservedHeaders = map[string]bool{
+	"Authorization":       true,
+	"Connection":          true,
+	"Content-Encoding":    true,
+	"Content-Length":      true,
+	"Content-Type":        true,
+	"Cookie":              true,
+	"Host":                true,
+	"Keep-Alive":          true,
+	"Proxy-Authenticate":  true,
+	"Proxy-Authorization": true,
+	"Proxy-Connection":    true,
+	"Te":                  true,
+	"Trailer":             true,
+	"Transfer-Encoding":   true,
+	"Upgrade":             true,
+}
+
+const (
+	correlationIdKey contextKey = iota
+	deadlineHintKey
+	tenantKey
+	callerServiceKey
+	forwardedHeadersKey
+)
+
+// WithCorrelationId returns a copy of the parent context carrying the correlation ID
+func WithCorrelationId(parent context.Context, correlationId string) context.Context {
+	return context.WithValue(parent, correlationIdKey, correlationId)
+}
+
+// CorrelationId returns the correlation ID carried by the context, or an empty string if there is none. The value
+// stored with the common.CorrelationHeader string key is still returned as a fallback until all the callers have
+// migrated to WithCorrelationId.
+func CorrelationId(ctx context.Context) string {
+	if correlationId, ok := ctx.Value(correlationIdKey).(string); ok {
+		return correlationId
+	}
+	correlationId, _ := ctx.Value(common.CorrelationHeader).(string)
+	return correlationId
+}
+
+// WithDeadlineHint returns a copy of the parent context carrying the deadline hint. Unlike the deadline of the
+// context, the hint doesn't cancel anything, it tells the services down the line how long the caller is willing to
+// wait so that they can give up early.
+func WithDeadlineHint(parent context.Context, deadline time.Time) context.Context {
+	return context.WithValue(parent, deadlineHintKey, deadline)
+}
+
+// DeadlineHint returns the earliest of the deadline hint and the deadline of the context, if any
+func DeadlineHint(ctx context.Context) (time.Time, bool) {
+	hint, hasHint := ctx.Value(deadlineHintKey).(time.Time)
+	deadline, hasDeadline := ctx.Deadline()
+	switch {
+	case hasHint && hasDeadline && deadline.Before(hint):
+		return deadline, true
+	case hasHint:
+		return hint, true
+	default:
+		return deadline, hasDeadline
+	}
+}
+
+// WithTenant returns a copy of the parent context carrying the tenant
+func WithTenant(parent context.Context, tenant string) context.Context {
+	return context.WithValue(parent, tenantKey, tenant)
+}
+
+// Tenant returns the tenant carried by the context, or an empty string if there is none
+func Tenant(ctx context.Context) string {
+	tenant, _ := ctx.Value(tenantKey).(string)
+	return tenant
+}
+
+// WithCallerService returns a copy of the parent context carrying the name of the service making the requests,
+// usually the service key of the current service.
+func WithCallerService(parent context.Context, serviceName string) context.Context {
+	return context.WithValue(parent, callerServiceKey, serviceName)
+}
+
+// CallerService returns the name of the service making the requests carried by the context, or an empty string if
+// there is none
+func CallerService(ctx context.Context) string {
+	serviceName, _ := ctx.Value(callerServiceKey).(string)
+	return serviceName
+}
+
+// WithForwardedHeaders returns a copy of the parent context declaring extra headers that every outbound request made
+// with the context must forward. The headers are added to the ones already declared by the parent context.
+func WithForwardedHeaders(parent context.Context, header http.Header) context.Context {
+	forwarded := ForwardedHeaders(parent)
+	for name, values := range header {
+		forwarded[http.CanonicalHeaderKey(name)] = append([]string(nil), values...)
+	}
+	return context.WithValue(parent, forwardedHeadersKey, forwarded)
+}
+
+// ForwardedHeaders returns a copy of the extra headers declared by the context
+func ForwardedHeaders(ctx context.Context) http.Header {
+	forwarded, ok := ctx.Value(forwardedHeadersKey).(http.Header)
+	if !ok {
+		return http.Header{}
+	}
+	return forwarded.Clone()
+}
+
+// Extract returns a copy of the context carrying the metadata of the inbound request headers, i.e. the correlation
+// ID, the deadline hint, the tenant and the headers named by forwardedHeaderNames. The caller service header isn't
+// extracted since it names the service calling the current one, while the outbound requests are made by the current
+// service.
+func Extract(ctx context.Context, header http.Header, forwardedHeaderNames ...string) context.Context {
+	if correlationId := header.Get(common.CorrelationHeader); correlationId != "" {
+		ctx = WithCorrelationId(ctx, correlationId)
+	}
+	if deadline, err := time.Parse(time.RFC3339Nano, header.Get(common.DeadlineHeader)); err == nil {
+		ctx = WithDeadlineHint(ctx, deadline)
+	}
+	if tenant := header.Get(common.TenantHeader); tenant != "" {
+		ctx = WithTenant(ctx, tenant)
+	}
+
+	forwarded := http.Header{}
+	for _, name := range forwardedHeaderNames {
+		if values := header.Values(name); len(values) > 0 {
+			forwarded[http.CanonicalHeaderKey(name)] = values
+		}
+	}
+	if len(forwarded) > 0 {
+		ctx = WithForwardedHeaders(ctx, forwarded)
+	}
+	return ctx
+}
+
+// Inject sets the headers of the outbound request from the metadata carried by the context. The extra forwarded
+// headers are set first, so that they can't override the metadata headers, and never override the headers already set
+// by the client nor set the reserved and hop-by-hop headers, e.g. Content-Type or Authorization.
+func Inject(ctx context.Context, header http.Header) {
+	for name, values := range ForwardedHeaders(ctx) {
+		if reservedHeaders[name] || len(header.Values(name)) > 0 {
+			continue
+		}
+		header[name] = values
+	}
+	if correlationId := CorrelationId(ctx); correlationId != "" {
+		header.Set(common.CorrelationHeader, correlationId)
+	}
+	if deadline, ok := DeadlineHint(ctx); ok {
+		header.Set(common.DeadlineHeader, deadline.UTC().Format(time.RFC3339Nano))
+	}
+	if tenant := Tenant(ctx); tenant != "" {
+		header.Set(common.TenantHeader, tenant)
+	}
+	if serviceName := CallerService(ctx); serviceName != "" {
+		header.Set(common.CallerServiceHeader, serviceName)
+	}
+}
+
+// Propagate sets the headers propagating the request context to the next service, whatever the transport: the
+// metadata set by Inject, the Correlation ID, a new one if the context carries none, and the W3C Trace Context.
+func Propagate(ctx context.Context, header http.Header) {
+	Inject(ctx, header)
+	correlationId := CorrelationId(ctx)
+	if correlationId == "" {
+		correlationId = uuid.NewString()
+	}
+	header.Set(common.CorrelationHeader, correlationId)
+	tracecontext.Inject(ctx, header)
+}
+
+// Headers returns the headers propagating the request context to the next service, as set by Propagate
+func Headers(ctx context.Context) http.Header {
+	header := http.Header{}
+	Propagate(ctx, header)
+	return header
+}
@@ -0,0 +1,124 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requestcontext
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/tracecontext"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorrelationId(t *testing.T) {
+	assert.Empty(t, CorrelationId(context.Background()))
+
+	legacy := context.WithValue(context.Background(), common.CorrelationHeader, "legacy-id")
+	assert.Equal(t, "legacy-id", CorrelationId(legacy))
+
+	typed := WithCorrelationId(legacy, "typed-id")
+	assert.Equal(t, "typed-id", CorrelationId(typed))
+}
+
+func TestDeadlineHint(t *testing.T) {
+	_, ok := DeadlineHint(context.Background())
+	assert.False(t, ok)
+
+	hint := time.Now().Add(time.Minute)
+	ctx := WithDeadlineHint(context.Background(), hint)
+	deadline, ok := DeadlineHint(ctx)
+	require.True(t, ok)
+	assert.Equal(t, hint, deadline)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	expected, _ := ctx.Deadline()
+	deadline, ok = DeadlineHint(ctx)
+	require.True(t, ok)
+	assert.Equal(t, expected, deadline)
+}
+
+func TestForwardedHeaders(t *testing.T) {
+	ctx := WithForwardedHeaders(context.Background(), http.Header{"x-first": {"1"}})
+	ctx = WithForwardedHeaders(ctx, http.Header{"X-Second": {"2", "3"}})
+
+	forwarded := ForwardedHeaders(ctx)
+	assert.Equal(t, http.Header{"X-First": {"1"}, "X-Second": {"2", "3"}}, forwarded)
+
+	// the returned headers are a copy
+	forwarded.Set("X-First", "changed")
+	assert.Equal(t, "1", ForwardedHeaders(ctx).Get("X-First"))
+}
+
+func TestExtractAndInject(t *testing.T) {
+	deadline := time.Date(2021, 6, 1, 12, 0, 0, 500, time.UTC)
+	inbound := http.Header{}
+	inbound.Set(common.CorrelationHeader, "correlation-id")
+	inbound.Set(common.DeadlineHeader, deadline.Format(time.RFC3339Nano))
+	inbound.Set(common.TenantHeader, "tenant")
+	inbound.Set(common.CallerServiceHeader, common.CoreCommandServiceKey)
+	inbound.Set("X-Forwarded", "forwarded")
+	inbound.Set("X-Ignored", "ignored")
+
+	ctx := Extract(context.Background(), inbound, "x-forwarded")
+	assert.Equal(t, "correlation-id", CorrelationId(ctx))
+	assert.Equal(t, "tenant", Tenant(ctx))
+	assert.Empty(t, CallerService(ctx))
+	hint, ok := DeadlineHint(ctx)
+	require.True(t, ok)
+	assert.True(t, deadline.Equal(hint))
+
+	outbound := http.Header{}
+	Inject(WithCallerService(ctx, common.CoreDataServiceKey), outbound)
+	assert.Len(t, outbound, 5)
+	assert.Equal(t, "correlation-id", outbound.Get(common.CorrelationHeader))
+	assert.Equal(t, deadline.Format(time.RFC3339Nano), outbound.Get(common.DeadlineHeader))
+	assert.Equal(t, "tenant", outbound.Get(common.TenantHeader))
+	assert.Equal(t, common.CoreDataServiceKey, outbound.Get(common.CallerServiceHeader))
+	assert.Equal(t, "forwarded", outbound.Get("X-Forwarded"))
+}
+
+func TestInjectDoesNotOverrideClientHeaders(t *testing.T) {
+	ctx := WithForwardedHeaders(context.Background(), http.Header{
+		"Content-Type":      {"text/plain"},
+		"Content-Length":    {"1"},
+		"Authorization":     {"Bearer forwarded"},
+		"Transfer-Encoding": {"chunked"},
+		"X-Set-By-Client":   {"forwarded"},
+		"X-Forwarded":       {"forwarded"},
+	})
+	outbound := http.Header{}
+	outbound.Set(common.ContentType, common.ContentTypeJSON)
+	outbound.Set("X-Set-By-Client", "client")
+	Inject(ctx, outbound)
+
+	assert.Equal(t, http.Header{
+		"Content-Type":    {common.ContentTypeJSON},
+		"X-Set-By-Client": {"client"},
+		"X-Forwarded":     {"forwarded"},
+	}, outbound)
+}
+
+func TestHeaders(t *testing.T) {
+	header := Headers(context.Background())
+	assert.NotEmpty(t, header.Get(common.CorrelationHeader), "a new Correlation ID should be set")
+	_, ok := tracecontext.Parse(header.Get(tracecontext.TraceParentHeader), "")
+	assert.True(t, ok, "a new trace should be started")
+
+	trace := tracecontext.New()
+	ctx := tracecontext.NewContext(WithTenant(WithCorrelationId(context.Background(), "correlation-id"), "tenant"), trace)
+	header = Headers(ctx)
+	assert.Equal(t, "correlation-id", header.Get(common.CorrelationHeader))
+	assert.Equal(t, "tenant", header.Get(common.TenantHeader))
+	child, ok := tracecontext.Parse(header.Get(tracecontext.TraceParentHeader), "")
+	require.True(t, ok)
+	assert.Equal(t, trace.TraceId, child.TraceId)
+}
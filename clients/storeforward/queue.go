@@ -0,0 +1,173 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storeforward
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+const (
+	fileExtension = ".cbor"
+	tmpExtension  = ".tmp"
+)
+
+// item is a queued payload, stored in the file <sequence>-<enqueued Unix time in nanoseconds>.cbor
+type item struct {
+	sequence uint64
+	enqueued time.Time
+	size     int64
+}
+
+func (it item) fileName() string {
+	return fmt.Sprintf("%020d-%d%s", it.sequence, it.enqueued.UnixNano(), fileExtension)
+}
+
+// parseFileName parses the name of the file of an item, the other files of the directory being ignored
+func parseFileName(name string) (item, bool) {
+	if !strings.HasSuffix(name, fileExtension) {
+		return item{}, false
+	}
+	parts := strings.Split(strings.TrimSuffix(name, fileExtension), "-")
+	if len(parts) != 2 {
+		return item{}, false
+	}
+	sequence, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return item{}, false
+	}
+	enqueued, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return item{}, false
+	}
+	return item{sequence: sequence, enqueued: time.Unix(0, enqueued)}, true
+}
+
+// queue is a FIFO queue of payloads, each payload being stored in a file of the directory so that the queue survives a
+// restart. The index of the items is kept in memory, the payloads being read from the disk when dequeued. A queue isn't
+// safe for concurrent use.
+type queue struct {
+	dir   string
+	items []item
+	bytes int64
+	next  uint64
+}
+
+// openQueue opens the queue stored in the directory, creating the directory if needed
+func openQueue(dir string) (*queue, errors.EdgeX) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("failed to create the queue directory %s", dir), err)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("failed to read the queue directory %s", dir), err)
+	}
+
+	q := &queue{dir: dir}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		// a temporary file is left by a process stopped while writing it, the item wasn't queued
+		if strings.HasSuffix(file.Name(), tmpExtension) {
+			_ = os.Remove(filepath.Join(dir, file.Name()))
+			continue
+		}
+		it, ok := parseFileName(file.Name())
+		if !ok {
+			continue
+		}
+		it.size = file.Size()
+		q.items = append(q.items, it)
+		q.bytes += it.size
+	}
+	sort.Slice(q.items, func(i, j int) bool { return q.items[i].sequence < q.items[j].sequence })
+	if len(q.items) > 0 {
+		q.next = q.items[len(q.items)-1].sequence + 1
+	}
+	return q, nil
+}
+
+func (q *queue) len() int {
+	return len(q.items)
+}
+
+// push appends the payload to the queue. The payload is written to a temporary file renamed once synced, so that a
+// crash never leaves a partial item in the queue.
+func (q *queue) push(payload []byte, enqueued time.Time) errors.EdgeX {
+	it := item{sequence: q.next, enqueued: enqueued, size: int64(len(payload))}
+	path := filepath.Join(q.dir, it.fileName())
+	tmpPath := path + tmpExtension
+
+	file, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindIOError, "failed to create the queue file", err)
+	}
+	_, err = file.Write(payload)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return errors.NewCommonEdgeX(errors.KindIOError, "failed to write the queue file", err)
+	}
+
+	q.items = append(q.items, it)
+	q.bytes += it.size
+	q.next++
+	return nil
+}
+
+// peek returns the first item of the queue and its payload, ok being false if the queue is empty
+func (q *queue) peek() (it item, payload []byte, ok bool, err errors.EdgeX) {
+	if len(q.items) == 0 {
+		return item{}, nil, false, nil
+	}
+	it = q.items[0]
+	payload, readErr := ioutil.ReadFile(filepath.Join(q.dir, it.fileName()))
+	if readErr != nil {
+		return it, nil, true, errors.NewCommonEdgeX(errors.KindIOError, "failed to read the queue file", readErr)
+	}
+	return it, payload, true, nil
+}
+
+// remove removes the item of the sequence number, if it is still queued, and reports whether it was
+func (q *queue) remove(sequence uint64) bool {
+	for i, it := range q.items {
+		if it.sequence == sequence {
+			_ = os.Remove(filepath.Join(q.dir, it.fileName()))
+			if i == 0 {
+				q.items = q.items[1:]
+			} else {
+				q.items = append(q.items[:i:i], q.items[i+1:]...)
+			}
+			q.bytes -= it.size
+			return true
+		}
+	}
+	return false
+}
+
+// removeFirst removes the first item of the queue
+func (q *queue) removeFirst() {
+	if len(q.items) > 0 {
+		q.remove(q.items[0].sequence)
+	}
+}
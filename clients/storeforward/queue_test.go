@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storeforward
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func queuedPayloads(t *testing.T, q *queue) []string {
+	var payloads []string
+	for q.len() > 0 {
+		it, payload, ok, err := q.peek()
+		require.True(t, ok)
+		require.NoError(t, err)
+		payloads = append(payloads, string(payload))
+		require.True(t, q.remove(it.sequence))
+	}
+	return payloads
+}
+
+func TestQueue(t *testing.T) {
+	q, err := openQueue(filepath.Join(t.TempDir(), "queue"))
+	require.NoError(t, err, "the directory is created")
+	_, _, ok, err := q.peek()
+	assert.False(t, ok)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	require.NoError(t, q.push([]byte("first"), now))
+	require.NoError(t, q.push([]byte("second"), now))
+	require.NoError(t, q.push([]byte("third"), now))
+	assert.Equal(t, 3, q.len())
+	assert.Equal(t, int64(len("first")+len("second")+len("third")), q.bytes)
+
+	q.removeFirst()
+	assert.False(t, q.remove(q.items[0].sequence-1), "the item was already removed")
+	assert.Equal(t, []string{"second", "third"}, queuedPayloads(t, q))
+	assert.Zero(t, q.bytes)
+}
+
+func TestQueueReopen(t *testing.T) {
+	dir := t.TempDir()
+	q, err := openQueue(dir)
+	require.NoError(t, err)
+	enqueued := time.Unix(0, 1620000000123456789)
+	for _, payload := range []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"} {
+		require.NoError(t, q.push([]byte(payload), enqueued))
+	}
+	q.removeFirst()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "unknown.txt"), []byte("ignored"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "00000000000000000042-1.cbor.tmp"), []byte("partial"), 0600))
+
+	reopened, err := openQueue(dir)
+	require.NoError(t, err)
+	require.Equal(t, 10, reopened.len())
+	assert.True(t, enqueued.Equal(reopened.items[0].enqueued), "the enqueue time is kept")
+	assert.Equal(t, q.bytes, reopened.bytes)
+	require.NoError(t, reopened.push([]byte("12"), enqueued))
+	assert.Equal(t, []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}, queuedPayloads(t, reopened),
+		"the items are dequeued in order, including the ones queued after the reopening")
+
+	files, readErr := ioutil.ReadDir(dir)
+	require.NoError(t, readErr)
+	require.Len(t, files, 1, "the temporary file is removed")
+	assert.Equal(t, "unknown.txt", files[0].Name())
+}
+
+func TestParseFileName(t *testing.T) {
+	it := item{sequence: 42, enqueued: time.Unix(0, 1620000000123456789)}
+	parsed, ok := parseFileName(it.fileName())
+	require.True(t, ok)
+	assert.Equal(t, it.sequence, parsed.sequence)
+	assert.True(t, it.enqueued.Equal(parsed.enqueued))
+
+	for _, name := range []string{"unknown.txt", "42.cbor", "a-1.cbor", "42-a.cbor", "42-1-1.cbor"} {
+		_, ok := parseFileName(name)
+		assert.False(t, ok, name)
+	}
+}
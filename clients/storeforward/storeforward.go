@@ -0,0 +1,305 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package storeforward wraps an EventClient with a store-and-forward buffer, so that a gateway doesn't lose its
+// telemetry while core-data is unreachable. The events whose Add fails with a transient error, i.e. any error but a
+// rejection of the event by core-data, are encoded to CBOR and persisted to a bounded queue on the disk, and Add
+// answers with the 202 Accepted status code. The transient errors include the transport failures, the 5xx responses
+// and the 429 Too Many Requests responses of errors.KindLimitExceeded. A background goroutine replays the buffered
+// events in order, with an exponential backoff while core-data keeps failing transiently, and drops the events rejected
+// by core-data. The events added while the buffer isn't empty are buffered behind the older ones, so that core-data
+// receives all the events in the order they were added.
+//
+// The buffer is bounded by a number of events, a size and an age: the oldest events are dropped to make room for the
+// new ones, and the events older than the maximum age are dropped instead of being replayed. The buffered events
+// survive a restart of the process, the client created with the same directory replaying them.
+package storeforward
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+const (
+	defaultMaxEvents      = 10000
+	defaultMaxBytes       = 64 * 1024 * 1024
+	defaultMaxAge         = 24 * time.Hour
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = time.Minute
+)
+
+// Settings configures the buffer of an EventClient
+type Settings struct {
+	// Dir is the directory the buffered events are stored in. Required.
+	Dir string
+	// MaxEvents is the maximum number of buffered events. Default is 10000.
+	MaxEvents int
+	// MaxBytes is the maximum total size of the buffered events. Default is 64MiB.
+	MaxBytes int64
+	// MaxAge is how long an event is buffered before being dropped. Default is 24h.
+	MaxAge time.Duration
+	// InitialBackoff is the delay before replaying the events again once core-data is found unreachable. Default is 1s.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay, which is doubled after each failed replay. Default is 1m.
+	MaxBackoff time.Duration
+}
+
+func (s Settings) withDefaults() Settings {
+	if s.MaxEvents <= 0 {
+		s.MaxEvents = defaultMaxEvents
+	}
+	if s.MaxBytes <= 0 {
+		s.MaxBytes = defaultMaxBytes
+	}
+	if s.MaxAge <= 0 {
+		s.MaxAge = defaultMaxAge
+	}
+	if s.InitialBackoff <= 0 {
+		s.InitialBackoff = defaultInitialBackoff
+	}
+	if s.MaxBackoff <= 0 {
+		s.MaxBackoff = defaultMaxBackoff
+	}
+	return s
+}
+
+// backoff returns the delay before the next replay after the specified number of failed ones
+func (s Settings) backoff(failures int) time.Duration {
+	delay := s.InitialBackoff
+	for i := 1; i < failures && delay < s.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > s.MaxBackoff {
+		return s.MaxBackoff
+	}
+	return delay
+}
+
+// Metrics reports the state of the buffer of an EventClient
+type Metrics struct {
+	// Depth is the number of buffered events
+	Depth int
+	// Bytes is the total size of the buffered events
+	Bytes int64
+	// Buffered is the number of events which were buffered
+	Buffered uint64
+	// Forwarded is the number of buffered events which were replayed to core-data
+	Forwarded uint64
+	// Dropped is the number of buffered events which were dropped, because of the limits of the buffer, or because
+	// core-data rejected them with a 4xx response when replayed
+	Dropped uint64
+}
+
+// EventClient buffers the events which can't be added because core-data is unreachable or fails transiently, and
+// replays them once it adds them again. The other methods are passed through to the wrapped client.
+type EventClient struct {
+	interfaces.EventClient
+	settings Settings
+	queue    *queue
+	mutex    sync.Mutex
+	wake     chan struct{}
+	cancel   context.CancelFunc
+	done     chan struct{}
+
+	buffered  uint64
+	forwarded uint64
+	dropped   uint64
+}
+
+// NewEventClient wraps the EventClient with a buffer stored in settings.Dir, and starts replaying the events buffered
+// there by a previous process, if any. Close stops the replay.
+func NewEventClient(client interfaces.EventClient, settings Settings) (*EventClient, errors.EdgeX) {
+	if settings.Dir == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the directory of the buffer is required", nil)
+	}
+	q, err := openQueue(settings.Dir)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &EventClient{
+		EventClient: client,
+		settings:    settings.withDefaults(),
+		queue:       q,
+		wake:        make(chan struct{}, 1),
+		cancel:      cancel,
+		done:        make(chan struct{}),
+	}
+	go c.replay(ctx)
+	return c, nil
+}
+
+// Add adds the event, or buffers it if core-data fails transiently or older events are still buffered. The response of a
+// buffered event has the 202 Accepted status code and the id of the event.
+func (c *EventClient) Add(ctx context.Context, req requests.AddEventRequest) (dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	c.mutex.Lock()
+	buffering := c.queue.len() > 0
+	c.mutex.Unlock()
+
+	if !buffering {
+		res, err := c.EventClient.Add(ctx, req)
+		if err == nil || rejected(err) {
+			return res, err
+		}
+	} else if err := req.Validate(); err != nil {
+		return dtoCommon.BaseWithIdResponse{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid AddEventRequest", err)
+	}
+
+	if err := c.buffer(req); err != nil {
+		return dtoCommon.BaseWithIdResponse{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtoCommon.NewBaseWithIdResponse(req.RequestId, "the event is buffered until core-data is reachable", http.StatusAccepted, req.Event.Id), nil
+}
+
+// rejected checks whether the error is a rejection of the event, which would never be added: a 4xx contract error of
+// core-data or of the validation of the request. The other errors, including errors.KindLimitExceeded since a 429 Too
+// Many Requests response and the rate limiter of the HTTP client fail with it, are transient and the event can be
+// added later.
+func rejected(err errors.EdgeX) bool {
+	switch errors.Kind(err) {
+	case errors.KindContractInvalid, errors.KindEntityDoesNotExist, errors.KindInvalidId, errors.KindDuplicateName,
+		errors.KindStatusConflict, errors.KindNotAllowed, errors.KindRangeNotSatisfiable:
+		return true
+	}
+	return false
+}
+
+// buffer persists the request to the queue, dropping the oldest events if the queue is full
+func (c *EventClient) buffer(req requests.AddEventRequest) errors.EdgeX {
+	payload, err := cbor.Marshal(req)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode AddEventRequest to CBOR", err)
+	}
+	size := int64(len(payload))
+	if size > c.settings.MaxBytes {
+		return errors.NewCommonEdgeX(errors.KindLimitExceeded, "the event is larger than the buffer", nil)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	now := time.Now()
+	c.dropExpired(now)
+	for c.queue.len() > 0 && (c.queue.len() >= c.settings.MaxEvents || c.queue.bytes+size > c.settings.MaxBytes) {
+		c.queue.removeFirst()
+		c.dropped++
+	}
+	if edgexErr := c.queue.push(payload, now); edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	c.buffered++
+
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// dropExpired drops the events older than the maximum age, the mutex being held by the caller
+func (c *EventClient) dropExpired(now time.Time) {
+	for c.queue.len() > 0 && now.Sub(c.queue.items[0].enqueued) > c.settings.MaxAge {
+		c.queue.removeFirst()
+		c.dropped++
+	}
+}
+
+// replay forwards the buffered events until the context is canceled, waiting for new events when the queue is empty
+// and backing off while core-data fails transiently
+func (c *EventClient) replay(ctx context.Context) {
+	defer close(c.done)
+	failures := 0
+	for {
+		if err := c.forward(ctx); err == nil {
+			failures = 0
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.wake:
+			}
+			continue
+		}
+
+		failures++
+		timer := time.NewTimer(c.settings.backoff(failures))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// forward adds the buffered events in order until the queue is empty, or an event can't be added because core-data
+// fails transiently or the replay is stopped. The events rejected by core-data are dropped, since they would never be
+// added.
+func (c *EventClient) forward(ctx context.Context) errors.EdgeX {
+	for ctx.Err() == nil {
+		c.mutex.Lock()
+		c.dropExpired(time.Now())
+		it, payload, ok, err := c.queue.peek()
+		c.mutex.Unlock()
+		if !ok {
+			return nil
+		}
+
+		var req requests.AddEventRequest
+		if err == nil {
+			if decodeErr := req.UnmarshalCBOR(payload); decodeErr != nil {
+				err = errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the buffered event", decodeErr)
+			}
+		}
+		if err == nil {
+			_, err = c.EventClient.Add(ctx, req)
+			// an Add interrupted by Close isn't rejected by core-data, the event stays buffered to be replayed later
+			if err != nil && (!rejected(err) || ctx.Err() != nil) {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
+		}
+
+		c.mutex.Lock()
+		// the event might have been dropped to make room for new ones while it was added
+		if c.queue.remove(it.sequence) {
+			if err == nil {
+				c.forwarded++
+			} else {
+				c.dropped++
+			}
+		}
+		c.mutex.Unlock()
+	}
+	return errors.NewCommonEdgeX(errors.KindCommunicationError, "the replay is stopped", ctx.Err())
+}
+
+// Metrics returns the state of the buffer
+func (c *EventClient) Metrics() Metrics {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.dropExpired(time.Now())
+	return Metrics{
+		Depth:     c.queue.len(),
+		Bytes:     c.queue.bytes,
+		Buffered:  c.buffered,
+		Forwarded: c.forwarded,
+		Dropped:   c.dropped,
+	}
+}
+
+// Close stops the replay of the buffered events, which stay on the disk to be replayed by the next EventClient created
+// with the same directory
+func (c *EventClient) Close() {
+	c.cancel()
+	<-c.done
+}
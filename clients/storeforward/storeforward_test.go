@@ -0,0 +1,365 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storeforward
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	clientsHttp "github.com/edgexfoundry/go-mod-core-contracts/v2/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/refserver"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// backend is an EventClient recording the added events. While it is down, it fails as the HTTP client of a core-data
+// which is down, and while it is blocked, its Add waits for the context to be canceled.
+type backend struct {
+	interfaces.EventClient
+	mutex    sync.Mutex
+	down     bool
+	reject   bool
+	blocked  chan struct{}
+	closed   interfaces.EventClient
+	attempts int
+	added    []int64
+}
+
+func (b *backend) Add(ctx context.Context, req requests.AddEventRequest) (dtoCommon.BaseWithIdResponse, errors.EdgeX) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.attempts++
+	switch {
+	case b.down:
+		if b.closed == nil {
+			ts := httptest.NewServer(http.NotFoundHandler())
+			ts.Close()
+			b.closed = clientsHttp.NewEventClient(ts.URL)
+		}
+		return b.closed.Add(ctx, req)
+	case b.blocked != nil:
+		close(b.blocked)
+		b.blocked = nil
+		<-ctx.Done()
+		return dtoCommon.BaseWithIdResponse{}, errors.NewCommonEdgeX(errors.KindServerError, "the request is canceled", ctx.Err())
+	case b.reject:
+		return dtoCommon.BaseWithIdResponse{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid event", nil)
+	}
+	b.added = append(b.added, req.Event.Origin)
+	return dtoCommon.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, req.Event.Id), nil
+}
+
+func (b *backend) setDown(down bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.down = down
+}
+
+func (b *backend) attemptCount() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.attempts
+}
+
+func (b *backend) addedOrigins() []int64 {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return append([]int64(nil), b.added...)
+}
+
+func newRequest(t *testing.T, origin int64) requests.AddEventRequest {
+	event := dtos.NewEvent("profile1", "device1", "source1")
+	event.Origin = origin
+	require.NoError(t, event.AddSimpleReading("temperature", common.ValueTypeInt32, int32(origin)))
+	event.AddBinaryReading("image", []byte{1, 2, 3}, "image/png")
+	return requests.NewAddEventRequest(event)
+}
+
+func newTestClient(t *testing.T, client interfaces.EventClient, settings Settings) *EventClient {
+	if settings.Dir == "" {
+		settings.Dir = t.TempDir()
+	}
+	if settings.InitialBackoff == 0 {
+		settings.InitialBackoff = 5 * time.Millisecond
+		settings.MaxBackoff = 20 * time.Millisecond
+	}
+	c, err := NewEventClient(client, settings)
+	require.NoError(t, err)
+	t.Cleanup(c.Close)
+	return c
+}
+
+func mustAdd(t *testing.T, c *EventClient, origin int64) dtoCommon.BaseWithIdResponse {
+	req := newRequest(t, origin)
+	res, err := c.Add(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, req.Event.Id, res.Id)
+	return res
+}
+
+func waitForDepth(t *testing.T, c *EventClient, depth int) {
+	require.Eventually(t, func() bool { return c.Metrics().Depth == depth }, 2*time.Second, 5*time.Millisecond,
+		"the buffer has %d events instead of %d", c.Metrics().Depth, depth)
+}
+
+func TestAddWhileReachable(t *testing.T) {
+	b := &backend{}
+	c := newTestClient(t, b, Settings{})
+
+	res := mustAdd(t, c, 1)
+	assert.Equal(t, http.StatusCreated, res.StatusCode, "the response of core-data is returned")
+	assert.Equal(t, []int64{1}, b.addedOrigins())
+	assert.Equal(t, Metrics{}, c.Metrics())
+}
+
+func TestBufferAndReplay(t *testing.T) {
+	b := &backend{down: true}
+	c := newTestClient(t, b, Settings{})
+
+	for origin := int64(1); origin <= 3; origin++ {
+		res := mustAdd(t, c, origin)
+		assert.Equal(t, http.StatusAccepted, res.StatusCode)
+	}
+	metrics := c.Metrics()
+	assert.Equal(t, 3, metrics.Depth)
+	assert.Positive(t, metrics.Bytes)
+	assert.Equal(t, uint64(3), metrics.Buffered)
+	assert.Empty(t, b.addedOrigins())
+
+	b.setDown(false)
+	waitForDepth(t, c, 0)
+	assert.Equal(t, []int64{1, 2, 3}, b.addedOrigins(), "the events are replayed in order")
+	assert.Equal(t, Metrics{Buffered: 3, Forwarded: 3}, c.Metrics())
+
+	res := mustAdd(t, c, 4)
+	assert.Equal(t, http.StatusCreated, res.StatusCode, "the event is added directly once the buffer is empty")
+	assert.Equal(t, []int64{1, 2, 3, 4}, b.addedOrigins())
+}
+
+func TestBufferAndReplayOverHttp(t *testing.T) {
+	server := refserver.NewServer(refserver.Store{})
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	closed := httptest.NewServer(server)
+	closed.Close()
+
+	dir := t.TempDir()
+	down := newTestClient(t, clientsHttp.NewEventClient(closed.URL), Settings{Dir: dir, InitialBackoff: time.Hour, MaxBackoff: time.Hour})
+	res := mustAdd(t, down, 1)
+	assert.Equal(t, http.StatusAccepted, res.StatusCode, "the event is buffered while core-data is down")
+	down.Close()
+
+	// the replay backs off instead of dropping the event while core-data is unavailable
+	server.InjectFault(refserver.Fault{Method: http.MethodPost, StatusCode: http.StatusServiceUnavailable, Times: 2})
+	c := newTestClient(t, clientsHttp.NewEventClient(ts.URL), Settings{Dir: dir})
+	waitForDepth(t, c, 0)
+	assert.Equal(t, Metrics{Forwarded: 1}, c.Metrics())
+
+	server.InjectFault(refserver.Fault{Method: http.MethodPost, StatusCode: http.StatusServiceUnavailable, Times: 1})
+	res = mustAdd(t, c, 2)
+	assert.Equal(t, http.StatusAccepted, res.StatusCode, "the event is buffered while core-data is unavailable")
+	waitForDepth(t, c, 0)
+	assert.Equal(t, Metrics{Buffered: 1, Forwarded: 2}, c.Metrics())
+
+	count, err := server.Events.EventCount(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), count.Count)
+}
+
+func TestTransientErrorsAreRetried(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+	}{
+		{"too many requests", http.StatusTooManyRequests},
+		{"server error", http.StatusInternalServerError},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			server := refserver.NewServer(refserver.Store{})
+			ts := httptest.NewServer(server)
+			defer ts.Close()
+			c := newTestClient(t, clientsHttp.NewEventClient(ts.URL), Settings{})
+
+			server.InjectFault(refserver.Fault{Method: http.MethodPost, StatusCode: testCase.statusCode, Times: 3})
+			res := mustAdd(t, c, 1)
+			assert.Equal(t, http.StatusAccepted, res.StatusCode, "the event is buffered")
+			// the replay backs off instead of dropping the event while core-data keeps failing
+			waitForDepth(t, c, 0)
+			assert.Equal(t, Metrics{Buffered: 1, Forwarded: 1}, c.Metrics())
+
+			count, err := server.Events.EventCount(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, uint32(1), count.Count)
+		})
+	}
+}
+
+func TestBufferBehindOlderEvents(t *testing.T) {
+	dir := t.TempDir()
+	b := &backend{down: true}
+	c := newTestClient(t, b, Settings{Dir: dir, InitialBackoff: time.Hour, MaxBackoff: time.Hour})
+	mustAdd(t, c, 1)
+	// the failed replay of the event puts the replay in backoff for the rest of the test
+	require.Eventually(t, func() bool { return b.attemptCount() >= 2 }, 2*time.Second, 5*time.Millisecond)
+
+	b.setDown(false)
+	res := mustAdd(t, c, 2)
+	assert.Equal(t, http.StatusAccepted, res.StatusCode, "the event is buffered behind the older one")
+	assert.Equal(t, 2, c.Metrics().Depth)
+	assert.Empty(t, b.addedOrigins())
+	c.Close()
+
+	restarted := newTestClient(t, b, Settings{Dir: dir})
+	waitForDepth(t, restarted, 0)
+	assert.Equal(t, []int64{1, 2}, b.addedOrigins())
+}
+
+func TestOtherErrorsAreReturned(t *testing.T) {
+	b := &backend{reject: true}
+	c := newTestClient(t, b, Settings{})
+
+	_, err := c.Add(context.Background(), newRequest(t, 1))
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Zero(t, c.Metrics().Depth, "an event rejected by core-data isn't buffered")
+}
+
+func TestInvalidEventIsNotBuffered(t *testing.T) {
+	b := &backend{down: true}
+	c := newTestClient(t, b, Settings{})
+	mustAdd(t, c, 1)
+
+	_, err := c.Add(context.Background(), requests.NewAddEventRequest(dtos.NewEvent("profile1", "device1", "source1")))
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Equal(t, 1, c.Metrics().Depth)
+}
+
+func TestRejectedReplayIsDropped(t *testing.T) {
+	b := &backend{down: true}
+	c := newTestClient(t, b, Settings{})
+	mustAdd(t, c, 1)
+
+	b.mutex.Lock()
+	b.down, b.reject = false, true
+	b.mutex.Unlock()
+	waitForDepth(t, c, 0)
+	assert.Equal(t, uint64(1), c.Metrics().Dropped)
+	assert.Empty(t, b.addedOrigins())
+}
+
+func TestCloseDuringReplay(t *testing.T) {
+	dir := t.TempDir()
+	b := &backend{down: true}
+	c := newTestClient(t, b, Settings{Dir: dir})
+	mustAdd(t, c, 1)
+
+	blocked := make(chan struct{})
+	b.mutex.Lock()
+	b.down, b.blocked = false, blocked
+	b.mutex.Unlock()
+	<-blocked
+	c.Close()
+	assert.Equal(t, Metrics{Depth: 1, Bytes: c.Metrics().Bytes, Buffered: 1}, c.Metrics(),
+		"the event whose replay is interrupted stays buffered")
+
+	restarted := newTestClient(t, b, Settings{Dir: dir})
+	waitForDepth(t, restarted, 0)
+	assert.Equal(t, []int64{1}, b.addedOrigins())
+}
+
+func TestSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	down := &backend{down: true}
+	c, err := NewEventClient(down, Settings{Dir: dir})
+	require.NoError(t, err)
+	for origin := int64(1); origin <= 3; origin++ {
+		mustAdd(t, c, origin)
+	}
+	c.Close()
+
+	up := &backend{}
+	restarted := newTestClient(t, up, Settings{Dir: dir})
+	waitForDepth(t, restarted, 0)
+	assert.Equal(t, []int64{1, 2, 3}, up.addedOrigins(), "the events buffered before the restart are replayed")
+}
+
+func TestLimits(t *testing.T) {
+	t.Run("MaxEvents", func(t *testing.T) {
+		b := &backend{down: true}
+		c := newTestClient(t, b, Settings{MaxEvents: 2})
+		for origin := int64(1); origin <= 4; origin++ {
+			mustAdd(t, c, origin)
+		}
+		assert.Equal(t, 2, c.Metrics().Depth)
+		assert.Equal(t, uint64(2), c.Metrics().Dropped)
+
+		b.setDown(false)
+		waitForDepth(t, c, 0)
+		assert.Equal(t, []int64{3, 4}, b.addedOrigins(), "the oldest events are dropped")
+	})
+
+	t.Run("MaxBytes", func(t *testing.T) {
+		b := &backend{down: true}
+		c := newTestClient(t, b, Settings{})
+		mustAdd(t, c, 1)
+		size := c.Metrics().Bytes
+
+		limited := newTestClient(t, b, Settings{MaxBytes: 2*size + size/2})
+		for origin := int64(1); origin <= 3; origin++ {
+			mustAdd(t, limited, origin)
+		}
+		assert.Equal(t, 2, limited.Metrics().Depth)
+		assert.Equal(t, uint64(1), limited.Metrics().Dropped)
+
+		tooLarge := newTestClient(t, b, Settings{MaxBytes: size / 2})
+		_, err := tooLarge.Add(context.Background(), newRequest(t, 1))
+		require.Error(t, err)
+		assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+	})
+
+	t.Run("MaxAge", func(t *testing.T) {
+		b := &backend{down: true}
+		c := newTestClient(t, b, Settings{MaxAge: 20 * time.Millisecond})
+		mustAdd(t, c, 1)
+		assert.Equal(t, 1, c.Metrics().Depth)
+
+		time.Sleep(30 * time.Millisecond)
+		mustAdd(t, c, 2)
+		assert.Equal(t, 1, c.Metrics().Depth, "the expired event is dropped")
+		b.setDown(false)
+		waitForDepth(t, c, 0)
+		assert.Equal(t, []int64{2}, b.addedOrigins())
+		assert.Equal(t, uint64(1), c.Metrics().Dropped)
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	settings := Settings{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}.withDefaults()
+	assert.Equal(t, 100*time.Millisecond, settings.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, settings.backoff(2))
+	assert.Equal(t, 800*time.Millisecond, settings.backoff(4))
+	assert.Equal(t, time.Second, settings.backoff(5))
+	assert.Equal(t, time.Second, settings.backoff(100))
+}
+
+func TestNewEventClientWithoutDir(t *testing.T) {
+	_, err := NewEventClient(&backend{}, Settings{})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
@@ -0,0 +1,204 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracecontext propagates the W3C Trace Context (https://www.w3.org/TR/trace-context/) across the EdgeX
+// services. The inbound server code extracts the traceparent and tracestate headers into the request context with
+// Extract, and the service clients re-emit them with Inject, so a request can be followed from service to service in
+// any OpenTelemetry compatible backend.
+package tracecontext
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	// TraceParentHeader is the header carrying the version, trace ID, parent span ID and trace flags
+	TraceParentHeader = "traceparent"
+	// TraceStateHeader is the header carrying the vendor specific trace data
+	TraceStateHeader = "tracestate"
+
+	// FlagSampled is the trace flag indicating the caller may have recorded the trace
+	FlagSampled byte = 0x01
+
+	traceParentVersion = "00"
+	traceParentLength  = 55
+	traceIdLength      = 32
+	spanIdLength       = 16
+)
+
+// TraceContext identifies the span of a request within a trace
+type TraceContext struct {
+	// TraceId is the lowercase hex encoded 16 bytes ID of the whole trace
+	TraceId string
+	// SpanId is the lowercase hex encoded 8 bytes ID of the span, i.e. the parent-id of the traceparent header
+	SpanId string
+	// Flags are the trace flags, see FlagSampled
+	Flags byte
+	// TraceState is the tracestate header, forwarded as is
+	TraceState string
+}
+
+type contextKey struct{}
+
+// sampleNewTraces is 1 if the traces started by New are sampled, see SetSampleNewTraces
+var sampleNewTraces int32
+
+// SetSampleNewTraces sets whether the traces started by New, e.g. by Inject when the context doesn't carry any trace
+// context, have the sampled flag set. Default is false, so that the sampling decision is left to the services down the
+// line unless the service is configured to sample the traces it starts.
+func SetSampleNewTraces(sampled bool) {
+	value := int32(0)
+	if sampled {
+		value = 1
+	}
+	atomic.StoreInt32(&sampleNewTraces, value)
+}
+
+// NewContext returns a copy of the parent context carrying the trace context
+func NewContext(parent context.Context, tc TraceContext) context.Context {
+	return context.WithValue(parent, contextKey{}, tc)
+}
+
+// FromContext returns the trace context carried by the context, if any
+func FromContext(ctx context.Context) (TraceContext, bool) {
+	tc, ok := ctx.Value(contextKey{}).(TraceContext)
+	return tc, ok
+}
+
+// TraceIdFromContext returns the trace ID carried by the context, or an empty string if there is none
+func TraceIdFromContext(ctx context.Context) string {
+	tc, _ := FromContext(ctx)
+	return tc.TraceId
+}
+
+// SpanIdFromContext returns the span ID carried by the context, or an empty string if there is none
+func SpanIdFromContext(ctx context.Context) string {
+	tc, _ := FromContext(ctx)
+	return tc.SpanId
+}
+
+// New starts a new trace with random trace and span IDs, sampled only if enabled by SetSampleNewTraces
+func New() TraceContext {
+	tc := TraceContext{TraceId: randomId(traceIdLength / 2), SpanId: randomId(spanIdLength / 2)}
+	if atomic.LoadInt32(&sampleNewTraces) == 1 {
+		tc.Flags = FlagSampled
+	}
+	return tc
+}
+
+// NewChild returns the trace context of a new span within the same trace, e.g. for an outbound request made by the
+// service on behalf of the inbound one.
+func (tc TraceContext) NewChild() TraceContext {
+	tc.SpanId = randomId(spanIdLength / 2)
+	return tc
+}
+
+// Sampled checks whether the sampled flag is set
+func (tc TraceContext) Sampled() bool {
+	return tc.Flags&FlagSampled != 0
+}
+
+// TraceParent formats the traceparent header of the trace context
+func (tc TraceContext) TraceParent() string {
+	return fmt.Sprintf("%s-%s-%s-%02x", traceParentVersion, tc.TraceId, tc.SpanId, tc.Flags)
+}
+
+// Parse parses the traceparent and tracestate headers. It returns false if the traceparent header is missing or
+// invalid, in which case the tracestate header must be discarded as well.
+func Parse(traceParent string, traceState string) (TraceContext, bool) {
+	traceParent = strings.TrimSpace(traceParent)
+	if len(traceParent) < traceParentLength {
+		return TraceContext{}, false
+	}
+	version := traceParent[:2]
+	if !isHex(version) || version == "ff" {
+		return TraceContext{}, false
+	}
+	// The later versions may append fields, but must keep the fields of the version 00
+	if version == traceParentVersion && len(traceParent) != traceParentLength {
+		return TraceContext{}, false
+	}
+	if len(traceParent) > traceParentLength && traceParent[traceParentLength] != '-' {
+		return TraceContext{}, false
+	}
+	if traceParent[2] != '-' || traceParent[35] != '-' || traceParent[52] != '-' {
+		return TraceContext{}, false
+	}
+
+	traceId := traceParent[3:35]
+	spanId := traceParent[36:52]
+	flags := traceParent[53:55]
+	if !isHex(traceId) || isZero(traceId) || !isHex(spanId) || isZero(spanId) || !isHex(flags) {
+		return TraceContext{}, false
+	}
+	flagBytes, _ := hex.DecodeString(flags)
+	return TraceContext{
+		TraceId:    traceId,
+		SpanId:     spanId,
+		Flags:      flagBytes[0],
+		TraceState: strings.TrimSpace(traceState),
+	}, true
+}
+
+// Extract returns a copy of the context carrying the trace context of the inbound request headers. The context is
+// returned unchanged if the headers don't carry a valid trace context.
+func Extract(ctx context.Context, header http.Header) context.Context {
+	tc, ok := Parse(header.Get(TraceParentHeader), strings.Join(header.Values(TraceStateHeader), ","))
+	if !ok {
+		return ctx
+	}
+	return NewContext(ctx, tc)
+}
+
+// Inject sets the traceparent and tracestate headers of the outbound request from a child of the trace context carried
+// by the context, so that the outbound request gets its own span within the trace. When the context doesn't carry any
+// trace context, a new trace is started so that the services down the line can still correlate their spans.
+func Inject(ctx context.Context, header http.Header) {
+	tc, ok := FromContext(ctx)
+	if ok {
+		tc = tc.NewChild()
+	} else {
+		tc = New()
+	}
+	header.Set(TraceParentHeader, tc.TraceParent())
+	if tc.TraceState != "" {
+		header.Set(TraceStateHeader, tc.TraceState)
+	} else {
+		header.Del(TraceStateHeader)
+	}
+}
+
+func randomId(size int) string {
+	id := make([]byte, size)
+	for {
+		// crypto/rand never fails on the supported platforms, an all zero ID is invalid and drawn again
+		_, _ = rand.Read(id)
+		for _, b := range id {
+			if b != 0 {
+				return hex.EncodeToString(id)
+			}
+		}
+	}
+}
+
+// isHex checks whether the value only contains lowercase hex digits
+func isHex(value string) bool {
+	for _, c := range value {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func isZero(value string) bool {
+	return strings.Trim(value, "0") == ""
+}
@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracecontext
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTraceId     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testSpanId      = "00f067aa0ba902b7"
+	testTraceParent = "00-" + testTraceId + "-" + testSpanId + "-01"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		traceParent string
+		valid       bool
+		flags       byte
+	}{
+		{"valid", testTraceParent, true, FlagSampled},
+		{"not sampled", "00-" + testTraceId + "-" + testSpanId + "-00", true, 0},
+		{"future version with extra field", "cc-" + testTraceId + "-" + testSpanId + "-01-what-the-future-holds", true, FlagSampled},
+		{"empty", "", false, 0},
+		{"forbidden version", "ff-" + testTraceId + "-" + testSpanId + "-01", false, 0},
+		{"version 00 with extra field", testTraceParent + "-extra", false, 0},
+		{"uppercase trace ID", "00-4BF92F3577B34DA6A3CE929D0E0E4736-" + testSpanId + "-01", false, 0},
+		{"zero trace ID", "00-00000000000000000000000000000000-" + testSpanId + "-01", false, 0},
+		{"zero span ID", "00-" + testTraceId + "-0000000000000000-01", false, 0},
+		{"wrong separator", "00_" + testTraceId + "-" + testSpanId + "-01", false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc, ok := Parse(tt.traceParent, "vendor=value")
+			require.Equal(t, tt.valid, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, testTraceId, tc.TraceId)
+			assert.Equal(t, testSpanId, tc.SpanId)
+			assert.Equal(t, tt.flags, tc.Flags)
+			assert.Equal(t, "vendor=value", tc.TraceState)
+		})
+	}
+}
+
+func TestExtractAndInject(t *testing.T) {
+	inbound := http.Header{}
+	inbound.Set(TraceParentHeader, testTraceParent)
+	inbound.Add(TraceStateHeader, "congo=t61rcWkgMzE")
+	inbound.Add(TraceStateHeader, "rojo=00f067aa0ba902b7")
+
+	ctx := Extract(context.Background(), inbound)
+	assert.Equal(t, testTraceId, TraceIdFromContext(ctx))
+	assert.Equal(t, testSpanId, SpanIdFromContext(ctx))
+
+	outbound := http.Header{}
+	Inject(ctx, outbound)
+	tc, ok := Parse(outbound.Get(TraceParentHeader), outbound.Get(TraceStateHeader))
+	require.True(t, ok)
+	assert.Equal(t, testTraceId, tc.TraceId)
+	assert.NotEqual(t, testSpanId, tc.SpanId, "the outbound request is a child span")
+	assert.True(t, tc.Sampled(), "the sampling decision of the caller is kept")
+	assert.Equal(t, "congo=t61rcWkgMzE,rojo=00f067aa0ba902b7", outbound.Get(TraceStateHeader))
+}
+
+func TestInjectStartsNewTrace(t *testing.T) {
+	header := http.Header{}
+	Inject(context.Background(), header)
+	tc, ok := Parse(header.Get(TraceParentHeader), "")
+	require.True(t, ok)
+	assert.False(t, tc.Sampled(), "the new traces aren't sampled by default")
+	assert.Empty(t, header.Get(TraceStateHeader))
+
+	child := tc.NewChild()
+	assert.Equal(t, tc.TraceId, child.TraceId)
+	assert.NotEqual(t, tc.SpanId, child.SpanId)
+}
+
+func TestExtractIgnoresInvalidHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set(TraceParentHeader, "invalid")
+	header.Set(TraceStateHeader, "vendor=value")
+	ctx := Extract(context.Background(), header)
+	_, ok := FromContext(ctx)
+	assert.False(t, ok)
+}
+
+func TestSampleNewTraces(t *testing.T) {
+	SetSampleNewTraces(true)
+	defer SetSampleNewTraces(false)
+	assert.True(t, New().Sampled())
+
+	header := http.Header{}
+	Inject(context.Background(), header)
+	tc, ok := Parse(header.Get(TraceParentHeader), "")
+	require.True(t, ok)
+	assert.True(t, tc.Sampled())
+}
@@ -254,6 +254,9 @@ const (
 const (
 	ClientMonitorDefault = 15000              // Defaults the interval at which a given service client will refresh its endpoint from the Registry, if used
 	CorrelationHeader    = "X-Correlation-ID" // Sets the key of the Correlation ID HTTP header
+	TenantHeader         = "X-Tenant-ID"      // Sets the key of the tenant HTTP header
+	CallerServiceHeader  = "X-Caller-Service" // Sets the key of the HTTP header naming the service making the request
+	DeadlineHeader       = "X-Deadline"       // Sets the key of the HTTP header carrying the deadline hint of the request in RFC 3339 format
 )
 
 // Constants related to how services identify themselves in the Service Registry
@@ -0,0 +1,58 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"errors"
+)
+
+// ResponseError is the EdgeX error built from the response of a failed request. It exposes the requestId and the
+// message reported by the service in the BaseResponse of the response body, while its kind is mapped from the status
+// code of the response.
+type ResponseError struct {
+	CommonEdgeX
+	// RequestId is the requestId of the BaseResponse, if any
+	RequestId string
+	// ServerMessage is the message of the BaseResponse, or the raw response body if it isn't a BaseResponse
+	ServerMessage string
+	// StatusCode is the status code of the BaseResponse, or the status code of the response if the body doesn't carry
+	// any status code
+	StatusCode int
+	// Items holds the error of each item when the service responds with an array of BaseResponse
+	Items []ResponseError
+}
+
+// NewResponseError creates a ResponseError whose kind is mapped from the status code. The message is the summary of
+// the error, e.g. including the status code, while serverMessage is the message reported by the service.
+func NewResponseError(statusCode int, requestId string, serverMessage string, message string, items []ResponseError) ResponseError {
+	kind := KindMapping(statusCode)
+	return ResponseError{
+		CommonEdgeX: CommonEdgeX{
+			kind:       kind,
+			callerInfo: getCallerInformation(),
+			message:    message,
+			code:       statusCode,
+		},
+		RequestId:     requestId,
+		ServerMessage: serverMessage,
+		StatusCode:    statusCode,
+		Items:         items,
+	}
+}
+
+// Unwrap returns the embedded CommonEdgeX so that the kind of the error can be determined by Kind.
+func (re ResponseError) Unwrap() error {
+	return re.CommonEdgeX
+}
+
+// AsResponseError finds the first ResponseError in the chain of errors.
+func AsResponseError(err error) (ResponseError, bool) {
+	var re ResponseError
+	if !errors.As(err, &re) {
+		return ResponseError{}, false
+	}
+	return re, true
+}
@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseError(t *testing.T) {
+	responseErr := NewResponseError(http.StatusConflict, "request-id", "device name exists", "request failed", nil)
+	wrapped := NewCommonEdgeXWrapper(NewCommonEdgeXWrapper(responseErr))
+
+	assert.Equal(t, KindStatusConflict, Kind(wrapped))
+	assert.Equal(t, http.StatusConflict, wrapped.Code())
+	assert.Equal(t, "request failed", wrapped.Error())
+
+	found, ok := AsResponseError(wrapped)
+	require.True(t, ok)
+	assert.Equal(t, "request-id", found.RequestId)
+	assert.Equal(t, "device name exists", found.ServerMessage)
+	assert.Equal(t, http.StatusConflict, found.StatusCode)
+
+	_, ok = AsResponseError(L5Error)
+	assert.False(t, ok)
+}